@@ -0,0 +1,345 @@
+package typact
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+
+	"go.l0nax.org/typact/internal/types"
+)
+
+// The presence byte is the first byte of the binary representation
+// of an [Option].
+const (
+	binaryNone byte = 0
+	binarySome byte = 1
+)
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+//
+// The binary representation starts with a presence byte: 0 for [None]
+// and 1 for [Some]. For [Some], the presence byte is followed by the
+// encoded value:
+//
+//   - Types implementing [encoding.BinaryMarshaler] use their own encoding.
+//   - Strings and byte slices are stored as is.
+//   - Signed and unsigned integers are stored as varint.
+//   - Floats are stored as IEEE 754 bits in little endian byte order.
+//   - Bools are stored as a single byte.
+//   - Any other type is encoded using [encoding/gob].
+func (o Option[T]) MarshalBinary() ([]byte, error) {
+	return o.appendBinary(nil)
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+//
+// See [Option.MarshalBinary] for the expected format.
+func (o *Option[T]) UnmarshalBinary(data []byte) error {
+	// reset first
+	o.some = false
+
+	if len(data) == 0 {
+		// only allocate in slow path.
+		// this overrides any previously defined value in the field.
+		o.val = types.ZeroValue[T]()
+
+		return fmt.Errorf("error unmarshaling binary data: %w", io.ErrUnexpectedEOF)
+	}
+
+	switch data[0] {
+	case binaryNone:
+		o.val = types.ZeroValue[T]()
+
+		if len(data) != 1 {
+			return errors.New("error unmarshaling binary data: trailing data after None")
+		}
+
+		return nil
+
+	case binarySome:
+		// handled below
+
+	default:
+		o.val = types.ZeroValue[T]()
+
+		return fmt.Errorf("error unmarshaling binary data: invalid presence byte 0x%02x", data[0])
+	}
+
+	var val T
+
+	if err := unmarshalBinary(&val, data[1:]); err != nil {
+		o.val = types.ZeroValue[T]()
+
+		return fmt.Errorf("error unmarshaling binary data: %w", err)
+	}
+
+	o.val = val
+	o.some = true
+
+	return nil
+}
+
+// GobEncode implements the [gob.GobEncoder] interface.
+//
+// It uses the same representation as [Option.MarshalBinary].
+func (o Option[T]) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements the [gob.GobDecoder] interface.
+//
+// It uses the same representation as [Option.UnmarshalBinary].
+func (o *Option[T]) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
+
+// appendBinary appends the binary representation of o to b.
+func (o Option[T]) appendBinary(b []byte) ([]byte, error) {
+	if !o.some {
+		return append(b, binaryNone), nil
+	}
+
+	b = append(b, binarySome)
+
+	switch val := any(o.val).(type) {
+	case binaryAppender:
+		return val.AppendBinary(b)
+
+	case encoding.BinaryMarshaler:
+		data, err := val.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		return append(b, data...), nil
+
+	case string:
+		return append(b, val...), nil
+
+	case []byte:
+		return append(b, val...), nil
+
+	case bool:
+		if val {
+			return append(b, 1), nil
+		}
+
+		return append(b, 0), nil
+
+	case int:
+		return binary.AppendVarint(b, int64(val)), nil
+
+	case int8:
+		return binary.AppendVarint(b, int64(val)), nil
+
+	case int16:
+		return binary.AppendVarint(b, int64(val)), nil
+
+	case int32:
+		return binary.AppendVarint(b, int64(val)), nil
+
+	case int64:
+		return binary.AppendVarint(b, val), nil
+
+	case uint:
+		return binary.AppendUvarint(b, uint64(val)), nil
+
+	case uint8:
+		return binary.AppendUvarint(b, uint64(val)), nil
+
+	case uint16:
+		return binary.AppendUvarint(b, uint64(val)), nil
+
+	case uint32:
+		return binary.AppendUvarint(b, uint64(val)), nil
+
+	case uint64:
+		return binary.AppendUvarint(b, val), nil
+
+	case float32:
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(val)), nil
+
+	case float64:
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(val)), nil
+	}
+
+	buf := bytes.NewBuffer(b)
+	if err := gob.NewEncoder(buf).Encode(o.val); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// unmarshalBinary decodes data, as encoded by [Option.appendBinary], into dest.
+func unmarshalBinary[T any](dest *T, data []byte) error {
+	if dec, ok := any(dest).(encoding.BinaryUnmarshaler); ok {
+		return dec.UnmarshalBinary(data)
+	}
+
+	// NOTE: Pointer types, e.g. *big.Int, implement the interface
+	// on the pointer itself, thus we have to allocate the value first.
+	if typ := reflect.TypeFor[T](); typ.Kind() == reflect.Pointer {
+		ptr := reflect.New(typ.Elem())
+
+		if dec, ok := ptr.Interface().(encoding.BinaryUnmarshaler); ok {
+			if err := dec.UnmarshalBinary(data); err != nil {
+				return err
+			}
+
+			reflect.ValueOf(dest).Elem().Set(ptr)
+
+			return nil
+		}
+	}
+
+	switch val := any(dest).(type) {
+	case *string:
+		*val = string(data)
+
+	case *[]byte:
+		*val = bytes.Clone(data)
+
+	case *bool:
+		if len(data) != 1 || data[0] > 1 {
+			return fmt.Errorf("invalid boolean value: %v", data)
+		}
+
+		*val = data[0] == 1
+
+	case *int:
+		num, err := readVarint(data, math.MinInt, math.MaxInt)
+		if err != nil {
+			return err
+		}
+
+		*val = int(num)
+
+	case *int8:
+		num, err := readVarint(data, math.MinInt8, math.MaxInt8)
+		if err != nil {
+			return err
+		}
+
+		*val = int8(num)
+
+	case *int16:
+		num, err := readVarint(data, math.MinInt16, math.MaxInt16)
+		if err != nil {
+			return err
+		}
+
+		*val = int16(num)
+
+	case *int32:
+		num, err := readVarint(data, math.MinInt32, math.MaxInt32)
+		if err != nil {
+			return err
+		}
+
+		*val = int32(num)
+
+	case *int64:
+		num, err := readVarint(data, math.MinInt64, math.MaxInt64)
+		if err != nil {
+			return err
+		}
+
+		*val = num
+
+	case *uint:
+		num, err := readUvarint(data, math.MaxUint)
+		if err != nil {
+			return err
+		}
+
+		*val = uint(num)
+
+	case *uint8:
+		num, err := readUvarint(data, math.MaxUint8)
+		if err != nil {
+			return err
+		}
+
+		*val = uint8(num)
+
+	case *uint16:
+		num, err := readUvarint(data, math.MaxUint16)
+		if err != nil {
+			return err
+		}
+
+		*val = uint16(num)
+
+	case *uint32:
+		num, err := readUvarint(data, math.MaxUint32)
+		if err != nil {
+			return err
+		}
+
+		*val = uint32(num)
+
+	case *uint64:
+		num, err := readUvarint(data, math.MaxUint64)
+		if err != nil {
+			return err
+		}
+
+		*val = num
+
+	case *float32:
+		if len(data) != 4 {
+			return fmt.Errorf("invalid float32 length: %d", len(data))
+		}
+
+		*val = math.Float32frombits(binary.LittleEndian.Uint32(data))
+
+	case *float64:
+		if len(data) != 8 {
+			return fmt.Errorf("invalid float64 length: %d", len(data))
+		}
+
+		*val = math.Float64frombits(binary.LittleEndian.Uint64(data))
+
+	default:
+		return gob.NewDecoder(bytes.NewReader(data)).Decode(dest)
+	}
+
+	return nil
+}
+
+// readVarint reads a single varint from data, which must not
+// contain any trailing data.
+func readVarint(data []byte, min, max int64) (int64, error) {
+	num, n := binary.Varint(data)
+	if n <= 0 || n != len(data) {
+		return 0, errors.New("invalid varint")
+	}
+
+	if num < min || num > max {
+		return 0, fmt.Errorf("value %d out of range", num)
+	}
+
+	return num, nil
+}
+
+// readUvarint reads a single uvarint from data, which must not
+// contain any trailing data.
+func readUvarint(data []byte, max uint64) (uint64, error) {
+	num, n := binary.Uvarint(data)
+	if n <= 0 || n != len(data) {
+		return 0, errors.New("invalid uvarint")
+	}
+
+	if num > max {
+		return 0, fmt.Errorf("value %d out of range", num)
+	}
+
+	return num, nil
+}
@@ -0,0 +1,39 @@
+//go:build go1.24
+// +build go1.24
+
+package typact
+
+import "encoding"
+
+// AppendBinary implements the [encoding.BinaryAppender] interface.
+//
+// It appends the same representation as [Option.MarshalBinary] to b.
+func (o Option[T]) AppendBinary(b []byte) ([]byte, error) {
+	return o.appendBinary(b)
+}
+
+// AppendText implements the [encoding.TextAppender] interface.
+//
+// It appends the same representation as [Option.MarshalText] to b,
+// i.e. nothing is appended if it is [None].
+func (o Option[T]) AppendText(b []byte) ([]byte, error) {
+	if !o.some {
+		return b, nil
+	}
+
+	if enc, ok := any(o.val).(textAppender); ok {
+		return enc.AppendText(b)
+	}
+
+	data, err := o.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, data...), nil
+}
+
+var (
+	_ encoding.BinaryAppender = Option[int]{}
+	_ encoding.TextAppender   = Option[int]{}
+)
@@ -0,0 +1,420 @@
+package main
+
+import (
+	"fmt"
+	"go/types"
+	"reflect"
+	"slices"
+	"strings"
+
+	"go.l0nax.org/typact/internal/codegen"
+)
+
+// directive is the comment directive which marks a struct for generation.
+const directive = "//typact:clone"
+
+// tagName is the struct tag which controls the cloning of a field.
+// See [clone.Deep] for the supported values.
+const tagName = "typact"
+
+const (
+	typactPath = "go.l0nax.org/typact"
+	stdPath    = "go.l0nax.org/typact/std"
+)
+
+// clonerKind describes how a type implements [std.Cloner].
+type clonerKind int
+
+const (
+	// clonerNone describes a type which does not implement [std.Cloner].
+	clonerNone clonerKind = iota
+	// clonerValue describes a type T which implements Clone() T.
+	clonerValue
+	// clonerPointer describes a type T where *T implements Clone() *T.
+	clonerPointer
+)
+
+type generator struct {
+	pkg  *codegen.Package
+	file *codegen.File
+
+	// targets holds all types for which a Clone method is generated.
+	targets map[*types.TypeName]bool
+	// visiting holds the named types which are currently cloned.
+	// It is used to detect recursive types, e.g. through pointers or slices.
+	visiting []types.Type
+}
+
+// generate returns the generated source for the package in dir.
+// It returns nil if there are no types to generate.
+func generate(dir, output string, extra []string) ([]byte, error) {
+	pkg, err := codegen.Load(dir, output)
+	if err != nil {
+		return nil, err
+	}
+
+	names := append(pkg.Annotated(directive), extra...)
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	g := &generator{
+		pkg:     pkg,
+		file:    codegen.NewFile(pkg.Types),
+		targets: make(map[*types.TypeName]bool),
+	}
+
+	objs := make([]*types.TypeName, 0, len(names))
+
+	for _, name := range names {
+		obj, err := pkg.Lookup(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if g.targets[obj] {
+			continue
+		}
+
+		g.targets[obj] = true
+		objs = append(objs, obj)
+	}
+
+	for _, obj := range objs {
+		if err := g.genClone(obj); err != nil {
+			return nil, fmt.Errorf("type %s: %w", obj.Name(), err)
+		}
+	}
+
+	std := g.file.Import(stdPath, "std")
+
+	g.file.Printf("var (\n")
+	for _, obj := range objs {
+		g.file.Printf("_ %s.Cloner[*%s] = (*%s)(nil)\n", std, obj.Name(), obj.Name())
+	}
+	g.file.Printf(")\n")
+
+	return g.file.Bytes(toolName)
+}
+
+// genClone generates the Clone method of obj.
+func (g *generator) genClone(obj *types.TypeName) error {
+	name := obj.Name()
+
+	g.file.Printf("// Clone returns a deep copy of x.\n")
+	g.file.Printf("func (x *%s) Clone() *%s {\n", name, name)
+	g.file.Printf("if x == nil {\nreturn nil\n}\n\n")
+	g.file.Printf("cpy := *x\n")
+
+	st := obj.Type().Underlying().(*types.Struct)
+	if err := g.fixupStruct("cpy", "x", st, 0); err != nil {
+		return err
+	}
+
+	g.file.Printf("\nreturn &cpy\n}\n\n")
+
+	return nil
+}
+
+// fixup generates the code to turn dst, holding a shallow copy of src,
+// into a deep copy of src.
+// depth is used to generate unique variable names.
+func (g *generator) fixup(dst, src string, typ types.Type, depth int) error {
+	if !g.needsDeepCopy(typ) {
+		return nil
+	}
+
+	if elem, ok := optionElem(typ); ok {
+		v := fmt.Sprintf("v%d", depth)
+		c := fmt.Sprintf("c%d", depth)
+		elemStr := g.file.TypeString(elem)
+
+		g.file.Printf("%s = %s.CloneWith(func(%s %s) %s {\n", dst, src, v, elemStr, elemStr)
+		g.file.Printf("%s := %s\n", c, v)
+
+		if err := g.fixup(c, v, elem, depth+1); err != nil {
+			return err
+		}
+
+		g.file.Printf("return %s\n})\n", c)
+
+		return nil
+	}
+
+	switch g.cloner(typ) {
+	case clonerValue:
+		g.file.Printf("%s = %s.Clone()\n", dst, src)
+		return nil
+
+	case clonerPointer:
+		g.file.Printf("%s = *%s.Clone()\n", dst, src)
+		return nil
+	}
+
+	if _, ok := typ.(*types.Named); ok {
+		if slices.ContainsFunc(g.visiting, func(t types.Type) bool {
+			return types.Identical(t, typ)
+		}) {
+			return fmt.Errorf(
+				"recursive type %s must implement std.Cloner or be annotated with %q",
+				g.file.TypeString(typ), directive,
+			)
+		}
+
+		g.visiting = append(g.visiting, typ)
+		defer func() {
+			g.visiting = g.visiting[:len(g.visiting)-1]
+		}()
+	}
+
+	switch under := typ.Underlying().(type) {
+	case *types.Pointer:
+		return g.fixupPointer(dst, src, under.Elem(), depth)
+
+	case *types.Slice:
+		g.file.Printf("if %s != nil {\n", src)
+		g.file.Printf("%s = make(%s, len(%s), cap(%s))\n", dst, g.file.TypeString(typ), src, src)
+		g.file.Printf("copy(%s, %s)\n", dst, src)
+
+		if g.needsDeepCopy(under.Elem()) {
+			i := fmt.Sprintf("i%d", depth)
+
+			g.file.Printf("for %s := range %s {\n", i, src)
+			if err := g.fixup(dst+"["+i+"]", src+"["+i+"]", under.Elem(), depth+1); err != nil {
+				return err
+			}
+			g.file.Printf("}\n")
+		}
+
+		g.file.Printf("}\n")
+
+	case *types.Map:
+		k := fmt.Sprintf("k%d", depth)
+		v := fmt.Sprintf("v%d", depth)
+
+		g.file.Printf("if %s != nil {\n", src)
+		g.file.Printf("%s = make(%s, len(%s))\n", dst, g.file.TypeString(typ), src)
+		g.file.Printf("for %s, %s := range %s {\n", k, v, src)
+
+		if g.needsDeepCopy(under.Elem()) {
+			c := fmt.Sprintf("c%d", depth)
+
+			g.file.Printf("%s := %s\n", c, v)
+			if err := g.fixup(c, v, under.Elem(), depth+1); err != nil {
+				return err
+			}
+
+			v = c
+		}
+
+		g.file.Printf("%s[%s] = %s\n", dst, k, v)
+		g.file.Printf("}\n}\n")
+
+	case *types.Array:
+		i := fmt.Sprintf("i%d", depth)
+
+		g.file.Printf("for %s := range %s {\n", i, src)
+		if err := g.fixup(dst+"["+i+"]", src+"["+i+"]", under.Elem(), depth+1); err != nil {
+			return err
+		}
+		g.file.Printf("}\n")
+
+	case *types.Struct:
+		return g.fixupStruct(dst, src, under, depth)
+	}
+
+	return nil
+}
+
+// fixupPointer generates the code to deep copy the pointer src of type *elem.
+func (g *generator) fixupPointer(dst, src string, elem types.Type, depth int) error {
+	if g.cloner(elem) == clonerPointer {
+		g.file.Printf("if %s != nil {\n%s = %s.Clone()\n}\n", src, dst, src)
+		return nil
+	}
+
+	p := fmt.Sprintf("p%d", depth)
+
+	g.file.Printf("if %s != nil {\n", src)
+	g.file.Printf("%s := *%s\n", p, src)
+
+	if err := g.fixup(p, "(*"+src+")", elem, depth+1); err != nil {
+		return err
+	}
+
+	g.file.Printf("%s = &%s\n}\n", dst, p)
+
+	return nil
+}
+
+// fixupStruct generates the code to deep copy all accessible fields of st.
+func (g *generator) fixupStruct(dst, src string, st *types.Struct, depth int) error {
+	for i := range st.NumFields() {
+		fld := st.Field(i)
+		if !g.isAccessible(fld) {
+			continue
+		}
+
+		name := fld.Name()
+
+		policy, err := fieldPolicy(st.Tag(i))
+		if err != nil {
+			return fmt.Errorf("field %s: %w", name, err)
+		}
+
+		switch policy {
+		case "shallow":
+			continue
+		case "skip":
+			g.file.Printf("%s.%s = %s\n", dst, name, g.zeroValue(fld.Type()))
+			continue
+		}
+
+		if err := g.fixup(dst+"."+name, src+"."+name, fld.Type(), depth); err != nil {
+			return fmt.Errorf("field %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// fieldPolicy returns the value of the "typact" struct tag.
+func fieldPolicy(tag string) (string, error) {
+	policy, _, _ := strings.Cut(reflect.StructTag(tag).Get(tagName), ",")
+
+	switch policy {
+	case "", "shallow", "skip":
+		return policy, nil
+	}
+
+	return "", fmt.Errorf("invalid %s tag %q", tagName, policy)
+}
+
+// zeroValue returns the expression of the zero value of typ.
+func (g *generator) zeroValue(typ types.Type) string {
+	switch under := typ.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case under.Info()&types.IsBoolean != 0:
+			return "false"
+		case under.Info()&types.IsString != 0:
+			return `""`
+		case under.Kind() == types.UnsafePointer:
+			return "nil"
+		}
+
+		return "0"
+
+	case *types.Struct, *types.Array:
+		return g.file.TypeString(typ) + "{}"
+	}
+
+	// pointers, slices, maps, channels, functions and interfaces
+	return "nil"
+}
+
+// needsDeepCopy reports whether a shallow copy of a value of typ
+// shares memory with the original value.
+func (g *generator) needsDeepCopy(typ types.Type) bool {
+	if elem, ok := optionElem(typ); ok {
+		return g.needsDeepCopy(elem)
+	}
+
+	if g.cloner(typ) != clonerNone {
+		return true
+	}
+
+	switch under := typ.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Map:
+		return true
+
+	case *types.Array:
+		return g.needsDeepCopy(under.Elem())
+
+	case *types.Struct:
+		for i := range under.NumFields() {
+			fld := under.Field(i)
+			if !g.isAccessible(fld) {
+				continue
+			}
+
+			switch policy, err := fieldPolicy(under.Tag(i)); {
+			case err != nil, policy == "skip":
+				// errors are reported by [generator.fixupStruct]
+				return true
+			case policy == "shallow":
+				continue
+			}
+
+			if g.needsDeepCopy(fld.Type()) {
+				return true
+			}
+		}
+	}
+
+	// basic types, interfaces, channels and functions.
+	return false
+}
+
+// cloner returns how typ implements [std.Cloner].
+func (g *generator) cloner(typ types.Type) clonerKind {
+	if _, ok := typ.(*types.Pointer); ok {
+		// handled by [generator.fixupPointer]
+		return clonerNone
+	}
+
+	if named, ok := typ.(*types.Named); ok && g.targets[named.Obj()] {
+		// the method will be generated
+		return clonerPointer
+	}
+
+	if isCloneMethod(types.NewMethodSet(typ), typ) {
+		return clonerValue
+	}
+
+	ptr := types.NewPointer(typ)
+	if isCloneMethod(types.NewMethodSet(ptr), ptr) {
+		return clonerPointer
+	}
+
+	return clonerNone
+}
+
+// isCloneMethod reports whether mset contains the method Clone() result.
+func isCloneMethod(mset *types.MethodSet, result types.Type) bool {
+	sel := mset.Lookup(nil, "Clone")
+	if sel == nil {
+		return false
+	}
+
+	sig, ok := sel.Type().(*types.Signature)
+	if !ok || sig.Params().Len() != 0 || sig.Results().Len() != 1 {
+		return false
+	}
+
+	return types.Identical(sig.Results().At(0).Type(), result)
+}
+
+// isAccessible reports whether fld can be accessed by the generated code.
+func (g *generator) isAccessible(fld *types.Var) bool {
+	if fld.Name() == "_" {
+		return false
+	}
+
+	return fld.Exported() || fld.Pkg() == g.pkg.Types
+}
+
+// optionElem returns T if typ is [typact.Option[T]].
+func optionElem(typ types.Type) (types.Type, bool) {
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return nil, false
+	}
+
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != typactPath || obj.Name() != "Option" {
+		return nil, false
+	}
+
+	return named.TypeArgs().At(0), true
+}
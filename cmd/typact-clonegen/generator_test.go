@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	const dir = "internal/testpkg"
+
+	got, err := generate(dir, "typact_clone.go", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile(filepath.Join(dir, "typact_clone.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, expected) {
+		t.Errorf("generated code differs from %s, run go generate:\n%s", dir, got)
+	}
+}
+
+func TestGenerate_errors(t *testing.T) {
+	tests := []struct {
+		typ      string
+		expected string
+	}{
+		{"DoesNotExist", "not found"},
+		{"HasRecursive", "recursive type Recursive"},
+		{"HasRecursivePtr", "recursive type RecursivePtr"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			_, err := generate("internal/testpkg", "typact_clone.go", []string{tt.typ})
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package testpkg
+
+import (
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"go.l0nax.org/typact"
+)
+
+func TestCollections_Clone(t *testing.T) {
+	num := 5
+	str := "foo"
+	strPtr := &str
+
+	src := &Collections{
+		Ints:       []int{1, 2},
+		Matrix:     [][]string{{"a"}, nil},
+		Labels:     map[string]string{"env": "dev"},
+		Nested:     map[string][]int{"a": {1}},
+		Ptr:        &num,
+		PtrPtr:     &strPtr,
+		Array:      [2][]byte{[]byte("x"), nil},
+		Inner:      Inner{Tags: []string{"tag"}},
+		InnerPtr:   &Inner{Tags: []string{"tag"}},
+		Children:   []*Basic{{Str: "child"}, nil},
+		Values:     []Basic{{Num: 1}},
+		Cloner:     Custom{Data: []int{1}},
+		unexported: []string{"hidden"},
+	}
+
+	cpy := src.Clone()
+
+	expected := *src
+	expected.Cloner = Custom{Data: []int{-1, 1}}
+
+	if !reflect.DeepEqual(*cpy, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, *cpy)
+	}
+
+	// modify the source, which must not affect the clone
+	src.Ints[0] = 100
+	src.Matrix[0][0] = "changed"
+	src.Labels["env"] = "changed"
+	src.Nested["a"][0] = 100
+	*src.Ptr = 100
+	**src.PtrPtr = "changed"
+	src.Array[0][0] = 'y'
+	src.Inner.Tags[0] = "changed"
+	src.InnerPtr.Tags[0] = "changed"
+	src.Children[0].Str = "changed"
+	src.unexported[0] = "changed"
+
+	switch {
+	case cpy.Ints[0] != 1,
+		cpy.Matrix[0][0] != "a",
+		cpy.Labels["env"] != "dev",
+		cpy.Nested["a"][0] != 1,
+		*cpy.Ptr != 5,
+		**cpy.PtrPtr != "foo",
+		cpy.Array[0][0] != 'x',
+		cpy.Inner.Tags[0] != "tag",
+		cpy.InnerPtr.Tags[0] != "tag",
+		cpy.Children[0].Str != "child",
+		cpy.unexported[0] != "hidden":
+
+		t.Errorf("clone shares memory with the source: %+v", *cpy)
+	}
+}
+
+func TestOptional_Clone(t *testing.T) {
+	src := &Optional{
+		Name:   typact.Some("foo"),
+		Tags:   typact.Some([]string{"a"}),
+		Basic:  typact.Some(&Basic{Str: "basic"}),
+		Nested: typact.Some(typact.Some(map[string]int{"a": 1})),
+	}
+
+	cpy := src.Clone()
+	if !reflect.DeepEqual(cpy, src) {
+		t.Fatalf("expected %+v, got %+v", src, cpy)
+	}
+
+	src.Tags.Unwrap()[0] = "changed"
+	src.Basic.Unwrap().Str = "changed"
+	src.Nested.Unwrap().Unwrap()["a"] = 100
+
+	if cpy.Tags.Unwrap()[0] != "a" ||
+		cpy.Basic.Unwrap().Str != "basic" ||
+		cpy.Nested.Unwrap().Unwrap()["a"] != 1 {
+		t.Errorf("clone shares memory with the source: %+v", cpy)
+	}
+}
+
+func TestNode_Clone(t *testing.T) {
+	src := &Node{
+		Value: 1,
+		Next:  &Node{Value: 2},
+		Children: []Node{
+			{Value: 3, Next: &Node{Value: 4}},
+		},
+	}
+
+	cpy := src.Clone()
+	if !reflect.DeepEqual(cpy, src) {
+		t.Fatalf("expected %+v, got %+v", src, cpy)
+	}
+
+	src.Next.Value = 100
+	src.Children[0].Next.Value = 100
+
+	if cpy.Next.Value != 2 || cpy.Children[0].Next.Value != 4 {
+		t.Errorf("clone shares memory with the source: %+v", cpy)
+	}
+
+	if (*Node)(nil).Clone() != nil {
+		t.Error("expected nil clone of nil node")
+	}
+}
+
+func TestTagged_Clone(t *testing.T) {
+	src := &Tagged{
+		Shared:  []int{1},
+		Skipped: map[string]int{"a": 1},
+		Count:   5,
+		Inner:   Inner{Name: "inner"},
+		Deep:    []int{1},
+		Nested:  map[string][]int{"a": {1}},
+	}
+	src.Parent = src
+	src.Handle = unsafe.Pointer(src)
+
+	cpy := src.Clone()
+
+	src.Shared[0] = 100
+	src.Deep[0] = 100
+
+	switch {
+	case cpy.Shared[0] != 100:
+		t.Error("expected shallow field to be shared")
+	case cpy.Skipped != nil, cpy.Count != 0, cpy.Inner.Name != "", cpy.Handle != nil:
+		t.Errorf("expected skipped fields to be zero, got %+v", cpy)
+	case cpy.Deep[0] != 1:
+		t.Error("expected untagged field to be cloned")
+	case cpy.Parent != src:
+		t.Error("expected shallow pointer to be copied by value")
+	}
+}
+
+func BenchmarkClone(b *testing.B) {
+	src := &Collections{
+		Ints:     []int{1, 2, 3, 4},
+		Labels:   map[string]string{"env": "dev"},
+		Children: []*Basic{{Str: "child"}},
+	}
+
+	b.Run("Generated", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for range b.N {
+			_ = src.Clone()
+		}
+	})
+
+	b.Run("Option", func(b *testing.B) {
+		opt := typact.Some(src)
+
+		b.ReportAllocs()
+
+		for range b.N {
+			_ = opt.Clone()
+		}
+	})
+}
@@ -0,0 +1,4 @@
+// Package testpkg contains the types used to test typact-clonegen.
+package testpkg
+
+//go:generate go run go.l0nax.org/typact/cmd/typact-clonegen
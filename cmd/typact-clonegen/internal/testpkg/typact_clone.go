@@ -0,0 +1,200 @@
+// Code generated by typact-clonegen. DO NOT EDIT.
+
+package testpkg
+
+import (
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/std"
+)
+
+// Clone returns a deep copy of x.
+func (x *Basic) Clone() *Basic {
+	if x == nil {
+		return nil
+	}
+
+	cpy := *x
+
+	return &cpy
+}
+
+// Clone returns a deep copy of x.
+func (x *Collections) Clone() *Collections {
+	if x == nil {
+		return nil
+	}
+
+	cpy := *x
+	if x.Ints != nil {
+		cpy.Ints = make([]int, len(x.Ints), cap(x.Ints))
+		copy(cpy.Ints, x.Ints)
+	}
+	if x.Matrix != nil {
+		cpy.Matrix = make([][]string, len(x.Matrix), cap(x.Matrix))
+		copy(cpy.Matrix, x.Matrix)
+		for i0 := range x.Matrix {
+			if x.Matrix[i0] != nil {
+				cpy.Matrix[i0] = make([]string, len(x.Matrix[i0]), cap(x.Matrix[i0]))
+				copy(cpy.Matrix[i0], x.Matrix[i0])
+			}
+		}
+	}
+	if x.Labels != nil {
+		cpy.Labels = make(map[string]string, len(x.Labels))
+		for k0, v0 := range x.Labels {
+			cpy.Labels[k0] = v0
+		}
+	}
+	if x.Nested != nil {
+		cpy.Nested = make(map[string][]int, len(x.Nested))
+		for k0, v0 := range x.Nested {
+			c0 := v0
+			if v0 != nil {
+				c0 = make([]int, len(v0), cap(v0))
+				copy(c0, v0)
+			}
+			cpy.Nested[k0] = c0
+		}
+	}
+	if x.Ptr != nil {
+		p0 := *x.Ptr
+		cpy.Ptr = &p0
+	}
+	if x.PtrPtr != nil {
+		p0 := *x.PtrPtr
+		if (*x.PtrPtr) != nil {
+			p1 := *(*x.PtrPtr)
+			p0 = &p1
+		}
+		cpy.PtrPtr = &p0
+	}
+	for i0 := range x.Array {
+		if x.Array[i0] != nil {
+			cpy.Array[i0] = make([]byte, len(x.Array[i0]), cap(x.Array[i0]))
+			copy(cpy.Array[i0], x.Array[i0])
+		}
+	}
+	if x.Inner.Tags != nil {
+		cpy.Inner.Tags = make([]string, len(x.Inner.Tags), cap(x.Inner.Tags))
+		copy(cpy.Inner.Tags, x.Inner.Tags)
+	}
+	if x.InnerPtr != nil {
+		p0 := *x.InnerPtr
+		if (*x.InnerPtr).Tags != nil {
+			p0.Tags = make([]string, len((*x.InnerPtr).Tags), cap((*x.InnerPtr).Tags))
+			copy(p0.Tags, (*x.InnerPtr).Tags)
+		}
+		cpy.InnerPtr = &p0
+	}
+	if x.Children != nil {
+		cpy.Children = make([]*Basic, len(x.Children), cap(x.Children))
+		copy(cpy.Children, x.Children)
+		for i0 := range x.Children {
+			if x.Children[i0] != nil {
+				cpy.Children[i0] = x.Children[i0].Clone()
+			}
+		}
+	}
+	if x.Values != nil {
+		cpy.Values = make([]Basic, len(x.Values), cap(x.Values))
+		copy(cpy.Values, x.Values)
+		for i0 := range x.Values {
+			cpy.Values[i0] = *x.Values[i0].Clone()
+		}
+	}
+	cpy.Cloner = x.Cloner.Clone()
+	if x.unexported != nil {
+		cpy.unexported = make([]string, len(x.unexported), cap(x.unexported))
+		copy(cpy.unexported, x.unexported)
+	}
+
+	return &cpy
+}
+
+// Clone returns a deep copy of x.
+func (x *Optional) Clone() *Optional {
+	if x == nil {
+		return nil
+	}
+
+	cpy := *x
+	cpy.Tags = x.Tags.CloneWith(func(v0 []string) []string {
+		c0 := v0
+		if v0 != nil {
+			c0 = make([]string, len(v0), cap(v0))
+			copy(c0, v0)
+		}
+		return c0
+	})
+	cpy.Basic = x.Basic.CloneWith(func(v0 *Basic) *Basic {
+		c0 := v0
+		if v0 != nil {
+			c0 = v0.Clone()
+		}
+		return c0
+	})
+	cpy.Nested = x.Nested.CloneWith(func(v0 typact.Option[map[string]int]) typact.Option[map[string]int] {
+		c0 := v0
+		c0 = v0.CloneWith(func(v1 map[string]int) map[string]int {
+			c1 := v1
+			if v1 != nil {
+				c1 = make(map[string]int, len(v1))
+				for k2, v2 := range v1 {
+					c1[k2] = v2
+				}
+			}
+			return c1
+		})
+		return c0
+	})
+
+	return &cpy
+}
+
+// Clone returns a deep copy of x.
+func (x *Node) Clone() *Node {
+	if x == nil {
+		return nil
+	}
+
+	cpy := *x
+	if x.Next != nil {
+		cpy.Next = x.Next.Clone()
+	}
+	if x.Children != nil {
+		cpy.Children = make([]Node, len(x.Children), cap(x.Children))
+		copy(cpy.Children, x.Children)
+		for i0 := range x.Children {
+			cpy.Children[i0] = *x.Children[i0].Clone()
+		}
+	}
+
+	return &cpy
+}
+
+// Clone returns a deep copy of x.
+func (x *Tagged) Clone() *Tagged {
+	if x == nil {
+		return nil
+	}
+
+	cpy := *x
+	cpy.Skipped = nil
+	cpy.Count = 0
+	cpy.Inner = Inner{}
+	if x.Deep != nil {
+		cpy.Deep = make([]int, len(x.Deep), cap(x.Deep))
+		copy(cpy.Deep, x.Deep)
+	}
+	cpy.Handle = nil
+
+	return &cpy
+}
+
+var (
+	_ std.Cloner[*Basic]       = (*Basic)(nil)
+	_ std.Cloner[*Collections] = (*Collections)(nil)
+	_ std.Cloner[*Optional]    = (*Optional)(nil)
+	_ std.Cloner[*Node]        = (*Node)(nil)
+	_ std.Cloner[*Tagged]      = (*Tagged)(nil)
+)
@@ -0,0 +1,114 @@
+package testpkg
+
+import (
+	"time"
+	"unsafe"
+
+	"go.l0nax.org/typact"
+)
+
+// Basic holds scalar values only.
+//
+//typact:clone
+type Basic struct {
+	Str   string
+	Num   int64
+	Float float64
+	Flag  bool
+	At    time.Time
+}
+
+// Collections holds values which must be deeply copied.
+//
+//typact:clone
+type Collections struct {
+	Ints     []int
+	Matrix   [][]string
+	Labels   map[string]string
+	Nested   map[string][]int
+	Ptr      *int
+	PtrPtr   **string
+	Array    [2][]byte
+	Inner    Inner
+	InnerPtr *Inner
+	Children []*Basic
+	Values   []Basic
+	Cloner   Custom
+	Func     func() string
+	Ch       chan int
+	Any      any
+
+	unexported []string
+}
+
+// Inner is an unannotated struct.
+type Inner struct {
+	Tags []string
+	Name string
+}
+
+// Optional holds Option values.
+//
+//typact:clone
+type Optional struct {
+	Name   typact.Option[string]
+	Tags   typact.Option[[]string]
+	Basic  typact.Option[*Basic]
+	Nested typact.Option[typact.Option[map[string]int]]
+}
+
+// Node is a recursive type.
+//
+//typact:clone
+type Node struct {
+	Value    int
+	Next     *Node
+	Children []Node
+}
+
+// Custom implements std.Cloner.
+type Custom struct {
+	Data []int
+}
+
+// Clone implements std.Cloner.
+func (c Custom) Clone() Custom {
+	return Custom{
+		Data: append([]int{-1}, c.Data...),
+	}
+}
+
+// Tagged uses struct tags to control the cloning.
+//
+//typact:clone
+type Tagged struct {
+	Shared  []int            `typact:"shallow"`
+	Skipped map[string]int   `typact:"skip"`
+	Count   int              `typact:"skip"`
+	Inner   Inner            `typact:"skip"`
+	Deep    []int            `json:"deep"`
+	Parent  *Tagged          `typact:"shallow"`
+	Nested  map[string][]int `typact:"shallow,omitempty"`
+	Handle  unsafe.Pointer   `typact:"skip"`
+}
+
+// Recursive is a recursive type which is not annotated.
+type Recursive struct {
+	Children []Recursive
+	Lookup   map[string]Recursive
+}
+
+// HasRecursive references an unannotated recursive type.
+type HasRecursive struct {
+	Tree Recursive
+}
+
+// RecursivePtr is a recursive type which is not annotated.
+type RecursivePtr struct {
+	Next *RecursivePtr
+}
+
+// HasRecursivePtr references an unannotated recursive type.
+type HasRecursivePtr struct {
+	List [2]RecursivePtr
+}
@@ -0,0 +1,75 @@
+// Command typact-clonegen generates Clone methods implementing [std.Cloner]
+// for structs.
+//
+// A struct is selected by annotating it with the "//typact:clone" directive
+// or by passing its name to the -type flag:
+//
+//	//typact:clone
+//	type MyData struct {
+//		ID   int
+//		Tags []string
+//	}
+//
+// The generated method uses a pointer receiver and deep-copies all slices,
+// maps, pointers, arrays and [typact.Option] values without using reflection:
+//
+//	func (x *MyData) Clone() *MyData
+//
+// Values of types implementing [std.Cloner] are cloned by calling their Clone method.
+// Interfaces, channels and functions are shared between the original and the clone.
+// Unexported fields of structs declared in other packages are copied by value.
+//
+// The cloning of a field can be controlled with the same struct tags as [clone.Deep]:
+//
+//	type MyData struct {
+//		Parent *MyData  `typact:"shallow"` // copied by value
+//		cache  []string `typact:"skip"`    // set to the zero value
+//	}
+//
+// Usage with go:generate:
+//
+//	//go:generate go run go.l0nax.org/typact/cmd/typact-clonegen
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const toolName = "typact-clonegen"
+
+func main() {
+	var (
+		dir      = flag.String("dir", ".", "directory of the package")
+		output   = flag.String("output", "typact_clone.go", "name of the generated file, relative to -dir")
+		typeList = flag.String("type", "", "comma-separated list of additional type names")
+	)
+
+	flag.Parse()
+
+	var extra []string
+	if *typeList != "" {
+		extra = strings.Split(*typeList, ",")
+	}
+
+	if err := run(*dir, *output, extra); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", toolName, err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, output string, extra []string) error {
+	src, err := generate(dir, output, extra)
+	if err != nil {
+		return err
+	}
+
+	if src == nil {
+		return fmt.Errorf("no types found: annotate a struct with %q or use -type", directive)
+	}
+
+	return os.WriteFile(filepath.Join(dir, output), src, 0o644)
+}
@@ -0,0 +1,516 @@
+package main
+
+import (
+	"fmt"
+	"go/types"
+	"reflect"
+	"slices"
+	"strings"
+
+	"go.l0nax.org/typact/internal/codegen"
+)
+
+// directive is the comment directive which marks a struct for generation.
+const directive = "//typact:hash"
+
+// tagName is the struct tag which controls the hashing of a field.
+// The only supported value is "-", which excludes the field.
+const tagName = "hash"
+
+const (
+	typactPath = "go.l0nax.org/typact"
+	xhashPath  = "go.l0nax.org/typact/std/xhash"
+)
+
+type generator struct {
+	pkg  *codegen.Package
+	file *codegen.File
+
+	// xhash is the name of the imported xhash package.
+	xhash string
+
+	// targets holds all types for which a Hash method is generated.
+	targets map[*types.TypeName]bool
+	// visiting holds the named types currently hashed inline.
+	// It is used to detect recursive types.
+	visiting []types.Type
+}
+
+// generate returns the generated source for the package in dir.
+// It returns nil if there are no types to generate.
+func generate(dir, output string, extra []string) ([]byte, error) {
+	pkg, err := codegen.Load(dir, output)
+	if err != nil {
+		return nil, err
+	}
+
+	names := append(pkg.Annotated(directive), extra...)
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	g := &generator{
+		pkg:     pkg,
+		file:    codegen.NewFile(pkg.Types),
+		targets: make(map[*types.TypeName]bool),
+	}
+
+	objs := make([]*types.TypeName, 0, len(names))
+
+	for _, name := range names {
+		obj, err := pkg.Lookup(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if g.targets[obj] {
+			continue
+		}
+
+		g.targets[obj] = true
+		objs = append(objs, obj)
+	}
+
+	g.xhash = g.file.Import(xhashPath, "xhash")
+
+	for _, obj := range objs {
+		if err := g.genHash(obj); err != nil {
+			return nil, fmt.Errorf("type %s: %w", obj.Name(), err)
+		}
+	}
+
+	g.file.Printf("var (\n")
+	for _, obj := range objs {
+		g.file.Printf("_ %s.Hashable = %s{}\n", g.xhash, obj.Name())
+	}
+	g.file.Printf(")\n")
+
+	return g.file.Bytes(toolName)
+}
+
+// genHash generates the Hash method of obj.
+func (g *generator) genHash(obj *types.TypeName) error {
+	name := obj.Name()
+
+	g.file.Printf("// Hash implements [%s.Hashable].\n", g.xhash)
+	g.file.Printf("func (x %s) Hash(h %s.Hasher) {\n", name, g.xhash)
+
+	st := obj.Type().Underlying().(*types.Struct)
+	if err := g.writeStruct("x", st, 0); err != nil {
+		return err
+	}
+
+	g.file.Printf("}\n\n")
+
+	return nil
+}
+
+// write generates the code to write src of type typ into the hasher h.
+// depth is used to generate unique variable names.
+func (g *generator) write(src string, typ types.Type, depth int) error {
+	if elem, ok := optionElem(typ); ok {
+		return g.writeOption(src, elem, depth)
+	}
+
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		// NOTE: Pointers must be handled before Hashable types,
+		// since the method set of *T contains the methods of T.
+		g.file.Printf("if %s == nil {\nh.WriteByte(0)\n} else {\nh.WriteByte(1)\n", src)
+
+		// NOTE: Methods, fields and array elements can be
+		// accessed through the pointer directly.
+		elem := "*" + src
+		if _, ok := optionElem(ptr.Elem()); !ok {
+			switch ptr.Elem().Underlying().(type) {
+			case *types.Struct, *types.Array:
+				elem = src
+			default:
+				if g.isHashable(ptr.Elem()) {
+					elem = src
+				}
+			}
+		}
+
+		if err := g.write(elem, ptr.Elem(), depth+1); err != nil {
+			return err
+		}
+
+		g.file.Printf("}\n")
+
+		return nil
+	}
+
+	if g.isHashable(typ) {
+		g.file.Printf("%s.Hash(h)\n", paren(src))
+		return nil
+	}
+
+	switch under := typ.Underlying().(type) {
+	case *types.Basic:
+		return g.writeBasic(src, typ, under)
+
+	case *types.Slice:
+		g.file.Printf("h.WriteUint64(uint64(len(%s)))\n", src)
+
+		if types.Identical(under.Elem(), types.Typ[types.Byte]) {
+			g.file.Printf("h.Write(%s)\n", src)
+			return nil
+		}
+
+		if g.isEmpty(under.Elem(), nil) {
+			return nil
+		}
+
+		e := fmt.Sprintf("e%d", depth)
+
+		g.file.Printf("for _, %s := range %s {\n", e, src)
+		if err := g.write(e, under.Elem(), depth+1); err != nil {
+			return err
+		}
+		g.file.Printf("}\n")
+
+	case *types.Array:
+		if g.isEmpty(typ, nil) {
+			return nil
+		}
+
+		i := fmt.Sprintf("i%d", depth)
+
+		g.file.Printf("for %s := range %s {\n", i, src)
+		if err := g.write(paren(src)+"["+i+"]", under.Elem(), depth+1); err != nil {
+			return err
+		}
+		g.file.Printf("}\n")
+
+	case *types.Map:
+		return g.writeMap(src, typ, under, depth)
+
+	case *types.Struct:
+		return g.writeNamedStruct(src, typ, under, depth)
+
+	case *types.Interface, *types.Chan, *types.Signature:
+		g.file.Printf("h.WriteInterface(%s)\n", src)
+
+	default:
+		return fmt.Errorf("unsupported type %s", g.file.TypeString(typ))
+	}
+
+	return nil
+}
+
+// writeOption generates the code to write the [typact.Option] src holding elem.
+func (g *generator) writeOption(src string, elem types.Type, depth int) error {
+	if g.isEmpty(elem, nil) {
+		g.file.Printf("if %s.IsSome() {\nh.WriteByte(1)\n} else {\nh.WriteByte(0)\n}\n", paren(src))
+		return nil
+	}
+
+	v := fmt.Sprintf("v%d", depth)
+
+	g.file.Printf("if %s, ok := %s.Deconstruct(); ok {\n", v, paren(src))
+	g.file.Printf("h.WriteByte(1)\n")
+
+	if err := g.write(v, elem, depth+1); err != nil {
+		return err
+	}
+
+	g.file.Printf("} else {\nh.WriteByte(0)\n}\n")
+
+	return nil
+}
+
+// writeBasic generates the code to write src of the basic type typ.
+func (g *generator) writeBasic(src string, typ types.Type, under *types.Basic) error {
+	info := under.Info()
+
+	switch {
+	case info&types.IsBoolean != 0:
+		g.file.Printf("if %s {\nh.WriteByte(1)\n} else {\nh.WriteByte(0)\n}\n", src)
+
+	case info&types.IsString != 0:
+		g.file.Printf("h.WriteUint64(uint64(len(%s)))\n", src)
+		g.file.Printf("h.WriteString(%s)\n", convert(types.String, src, typ))
+
+	case info&types.IsInteger != 0:
+		g.file.Printf("h.WriteUint64(%s)\n", convert(types.Uint64, src, typ))
+
+	case info&types.IsFloat != 0:
+		g.writeFloat(src, typ)
+
+	case info&types.IsComplex != 0:
+		part := types.Typ[types.Float64]
+		if under.Kind() == types.Complex64 {
+			part = types.Typ[types.Float32]
+		}
+
+		g.writeFloat("real("+src+")", part)
+		g.writeFloat("imag("+src+")", part)
+
+	case under.Kind() == types.UnsafePointer:
+		g.file.Printf("h.WriteInterface(%s)\n", src)
+
+	default:
+		return fmt.Errorf("unsupported type %s", g.file.TypeString(typ))
+	}
+
+	return nil
+}
+
+// writeFloat generates the code to write the float src of type typ.
+// Both, +0 and -0 are written as 0, since they are equal.
+func (g *generator) writeFloat(src string, typ types.Type) {
+	math := g.file.Import("math", "math")
+
+	g.file.Printf("if %s == 0 {\nh.WriteUint64(0)\n} else {\n", src)
+	g.file.Printf("h.WriteUint64(%s.Float64bits(%s))\n}\n", math, convert(types.Float64, src, typ))
+}
+
+// writeMap generates the code to write the map src, sorted by its keys.
+func (g *generator) writeMap(src string, typ types.Type, under *types.Map, depth int) error {
+	key, ok := under.Key().Underlying().(*types.Basic)
+	if !ok || key.Info()&types.IsOrdered == 0 {
+		return fmt.Errorf("key type %s of map %s is not ordered", g.file.TypeString(under.Key()), g.file.TypeString(typ))
+	}
+
+	k := fmt.Sprintf("k%d", depth)
+	v := fmt.Sprintf("v%d", depth)
+
+	g.file.Printf("h.WriteUint64(uint64(len(%s)))\n", src)
+	g.file.Printf("for _, %s := range %s.Sorted(%s.Keys(%s)) {\n",
+		k, g.file.Import("slices", "slices"), g.file.Import("maps", "maps"), src)
+
+	if err := g.write(k, under.Key(), depth+1); err != nil {
+		return err
+	}
+
+	if !g.isEmpty(under.Elem(), nil) {
+		// NOTE: map values are not addressable
+		g.file.Printf("%s := %s[%s]\n", v, paren(src), k)
+
+		if err := g.write(v, under.Elem(), depth+1); err != nil {
+			return err
+		}
+	}
+
+	g.file.Printf("}\n")
+
+	return nil
+}
+
+// writeNamedStruct generates the code to write the struct src of type typ inline.
+func (g *generator) writeNamedStruct(src string, typ types.Type, st *types.Struct, depth int) error {
+	if g.hasInaccessible(st) {
+		if !hasBinaryMarshaler(typ) {
+			return fmt.Errorf(
+				"type %s has unexported fields, it must implement xhash.Hashable or encoding.BinaryMarshaler",
+				g.file.TypeString(typ),
+			)
+		}
+
+		g.file.Printf("if data, err := %s.MarshalBinary(); err == nil {\n", paren(src))
+		g.file.Printf("h.WriteByte(1)\nh.WriteUint64(uint64(len(data)))\nh.Write(data)\n")
+		g.file.Printf("} else {\nh.WriteByte(0)\n}\n")
+
+		return nil
+	}
+
+	if slices.ContainsFunc(g.visiting, func(t types.Type) bool {
+		return types.Identical(t, typ)
+	}) {
+		return fmt.Errorf(
+			"recursive type %s must implement xhash.Hashable or be annotated with %q",
+			g.file.TypeString(typ), directive,
+		)
+	}
+
+	g.visiting = append(g.visiting, typ)
+	defer func() {
+		g.visiting = g.visiting[:len(g.visiting)-1]
+	}()
+
+	return g.writeStruct(src, st, depth)
+}
+
+// writeStruct generates the code to write all fields of st.
+func (g *generator) writeStruct(src string, st *types.Struct, depth int) error {
+	for i := range st.NumFields() {
+		fld := st.Field(i)
+		if fld.Name() == "_" {
+			continue
+		}
+
+		name := fld.Name()
+
+		skip, err := isSkipped(st.Tag(i))
+		if err != nil {
+			return fmt.Errorf("field %s: %w", name, err)
+		}
+
+		if skip {
+			continue
+		}
+
+		if err := g.write(paren(src)+"."+name, fld.Type(), depth); err != nil {
+			return fmt.Errorf("field %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// isSkipped reports whether the field with tag is excluded from the hash.
+func isSkipped(tag string) (bool, error) {
+	switch val := reflect.StructTag(tag).Get(tagName); val {
+	case "":
+		return false, nil
+	case "-":
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid %s tag %q", tagName, val)
+	}
+}
+
+// isEmpty reports whether nothing is written for values of typ,
+// i.e. it is a struct or array without any hashed field.
+// seen holds the struct types which are currently checked.
+func (g *generator) isEmpty(typ types.Type, seen []types.Type) bool {
+	if _, ok := optionElem(typ); ok || g.isHashable(typ) {
+		return false
+	}
+
+	switch under := typ.Underlying().(type) {
+	case *types.Array:
+		return under.Len() == 0 || g.isEmpty(under.Elem(), seen)
+
+	case *types.Struct:
+		if slices.ContainsFunc(seen, func(t types.Type) bool {
+			return types.Identical(t, typ)
+		}) || g.hasInaccessible(under) {
+			return false
+		}
+
+		seen = append(seen, typ)
+
+		for i := range under.NumFields() {
+			if under.Field(i).Name() == "_" {
+				continue
+			}
+
+			skip, err := isSkipped(under.Tag(i))
+			if err != nil {
+				// errors are reported by [generator.writeStruct]
+				return false
+			}
+
+			if skip {
+				continue
+			}
+
+			if !g.isEmpty(under.Field(i).Type(), seen) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// hasInaccessible reports whether st has fields which cannot be
+// accessed by the generated code.
+func (g *generator) hasInaccessible(st *types.Struct) bool {
+	for i := range st.NumFields() {
+		fld := st.Field(i)
+		if fld.Name() != "_" && !fld.Exported() && fld.Pkg() != g.pkg.Types {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isHashable reports whether typ implements [xhash.Hashable],
+// either with a value or a pointer receiver.
+func (g *generator) isHashable(typ types.Type) bool {
+	if named, ok := typ.(*types.Named); ok && g.targets[named.Obj()] {
+		// the method will be generated
+		return true
+	}
+
+	return isHashMethod(types.NewMethodSet(typ)) ||
+		isHashMethod(types.NewMethodSet(types.NewPointer(typ)))
+}
+
+// isHashMethod reports whether mset contains the method Hash(xhash.Hasher).
+func isHashMethod(mset *types.MethodSet) bool {
+	sel := mset.Lookup(nil, "Hash")
+	if sel == nil {
+		return false
+	}
+
+	sig, ok := sel.Type().(*types.Signature)
+	if !ok || sig.Params().Len() != 1 || sig.Results().Len() != 0 {
+		return false
+	}
+
+	named, ok := types.Unalias(sig.Params().At(0).Type()).(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == xhashPath && obj.Name() == "Hasher"
+}
+
+// hasBinaryMarshaler reports whether typ has the method MarshalBinary() ([]byte, error).
+func hasBinaryMarshaler(typ types.Type) bool {
+	sel := types.NewMethodSet(typ).Lookup(nil, "MarshalBinary")
+	if sel == nil {
+		return false
+	}
+
+	sig, ok := sel.Type().(*types.Signature)
+	if !ok || sig.Params().Len() != 0 || sig.Results().Len() != 2 {
+		return false
+	}
+
+	return types.Identical(sig.Results().At(0).Type(), types.NewSlice(types.Typ[types.Byte])) &&
+		types.Identical(sig.Results().At(1).Type(), types.Universe.Lookup("error").Type())
+}
+
+// paren returns src wrapped in parentheses if it is a dereferenced
+// pointer, which is required to access its fields, elements and methods.
+func paren(src string) string {
+	if strings.HasPrefix(src, "*") {
+		return "(" + src + ")"
+	}
+
+	return src
+}
+
+// convert returns the expression converting src of type typ to the basic type kind.
+func convert(kind types.BasicKind, src string, typ types.Type) string {
+	to := types.Typ[kind]
+	if types.Identical(typ, to) {
+		return src
+	}
+
+	return to.Name() + "(" + src + ")"
+}
+
+// optionElem returns T if typ is [typact.Option[T]].
+func optionElem(typ types.Type) (types.Type, bool) {
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return nil, false
+	}
+
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != typactPath || obj.Name() != "Option" {
+		return nil, false
+	}
+
+	return named.TypeArgs().At(0), true
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	const dir = "internal/testpkg"
+
+	got, err := generate(dir, "typact_hash.go", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile(filepath.Join(dir, "typact_hash.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, expected) {
+		t.Errorf("generated code differs from %s, run go generate:\n%s", dir, got)
+	}
+}
+
+func TestGenerate_errors(t *testing.T) {
+	tests := []struct {
+		typ      string
+		expected string
+	}{
+		{"DoesNotExist", "not found"},
+		{"UnorderedKey", "is not ordered"},
+		{"InvalidTag", `invalid hash tag "skip"`},
+		{"HasRecursive", "recursive type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			_, err := generate("internal/testpkg", "typact_hash.go", []string{tt.typ})
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,4 @@
+// Package testpkg contains the types used to test typact-hashgen.
+package testpkg
+
+//go:generate go run go.l0nax.org/typact/cmd/typact-hashgen
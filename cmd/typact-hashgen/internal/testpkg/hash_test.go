@@ -0,0 +1,122 @@
+package testpkg
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/std/xhash"
+)
+
+func hashOf(v xhash.Hashable) uint64 {
+	h := xhash.NewStableHasher()
+	v.Hash(h)
+
+	return h.Sum64()
+}
+
+func TestPair_prefixFree(t *testing.T) {
+	if hashOf(Pair{A: "ab", B: "c"}) == hashOf(Pair{A: "a", B: "bc"}) {
+		t.Error("expected different hashes for shifted strings")
+	}
+
+	if hashOf(Pair{A: "ab", B: "c"}) != hashOf(Pair{A: "ab", B: "c"}) {
+		t.Error("expected equal hashes for equal values")
+	}
+}
+
+func TestBasic_Hash(t *testing.T) {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	a := Basic{Str: "foo", Num: -1, Float: math.Copysign(0, -1), At: at}
+	b := Basic{Str: "foo", Num: -1, Float: 0, At: at}
+
+	if hashOf(a) != hashOf(b) {
+		t.Error("expected -0 and +0 to have equal hashes")
+	}
+
+	b.At = at.Add(time.Nanosecond)
+	if hashOf(a) == hashOf(b) {
+		t.Error("expected different times to have different hashes")
+	}
+}
+
+func TestCollections_Hash(t *testing.T) {
+	one, two := 1, 1
+
+	a := Collections{
+		Labels:   map[string]string{"a": "1", "b": "2", "c": "3"},
+		Ptr:      &one,
+		InnerPtr: &Inner{Name: "inner"},
+		Custom:   Custom{ID: 5},
+	}
+
+	b := Collections{
+		Labels:   map[string]string{"c": "3", "b": "2", "a": "1"},
+		Ptr:      &two,
+		InnerPtr: &Inner{Name: "inner"},
+		Custom:   Custom{ID: 5},
+	}
+
+	if hashOf(a) != hashOf(b) {
+		t.Error("expected equal values to have equal hashes")
+	}
+
+	b.Ptr = nil
+	if hashOf(a) == hashOf(b) {
+		t.Error("expected nil pointer to change the hash")
+	}
+
+	if hashOf(Collections{Matrix: [][]string{{"a", "b"}}}) == hashOf(Collections{Matrix: [][]string{{"a"}, {"b"}}}) {
+		t.Error("expected different nesting to have different hashes")
+	}
+
+	if hashOf(Collections{unexported: []string{"a"}}) == hashOf(Collections{}) {
+		t.Error("expected unexported fields to be hashed")
+	}
+}
+
+func TestOptional_Hash(t *testing.T) {
+	hashes := map[uint64]Optional{}
+
+	for _, v := range []Optional{
+		{},
+		{Name: typact.Some("")},
+		{Tags: typact.Some([]string(nil))},
+		{Basic: typact.Some[*Basic](nil)},
+		{Basic: typact.Some(&Basic{})},
+		{Nested: typact.Some(typact.None[map[string]int]())},
+		{Nested: typact.Some(typact.Some(map[string]int{}))},
+		{Marker: typact.Some(struct{}{})},
+	} {
+		hash := hashOf(v)
+		if prev, ok := hashes[hash]; ok {
+			t.Errorf("expected %+v and %+v to have different hashes", prev, v)
+		}
+
+		hashes[hash] = v
+	}
+}
+
+func TestTagged_Hash(t *testing.T) {
+	a := Tagged{Key: "foo", Cache: map[string][]byte{"a": nil}}
+	b := Tagged{Key: "foo", Parent: &a}
+
+	if hashOf(a) != hashOf(b) {
+		t.Error("expected skipped fields to be ignored")
+	}
+}
+
+func TestNode_Hash(t *testing.T) {
+	a := Node{Value: 1, Next: &Node{Value: 2}, Children: []Node{{Value: 3}}}
+	b := Node{Value: 1, Next: &Node{Value: 2}, Children: []Node{{Value: 3}}}
+
+	if hashOf(a) != hashOf(b) {
+		t.Error("expected equal nodes to have equal hashes")
+	}
+
+	b.Children[0].Next = &Node{}
+	if hashOf(a) == hashOf(b) {
+		t.Error("expected different nodes to have different hashes")
+	}
+}
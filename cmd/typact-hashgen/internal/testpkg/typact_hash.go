@@ -0,0 +1,245 @@
+// Code generated by typact-hashgen. DO NOT EDIT.
+
+package testpkg
+
+import (
+	"maps"
+	"math"
+	"slices"
+
+	"go.l0nax.org/typact/std/xhash"
+)
+
+// Hash implements [xhash.Hashable].
+func (x Basic) Hash(h xhash.Hasher) {
+	h.WriteUint64(uint64(len(x.Str)))
+	h.WriteString(x.Str)
+	h.WriteUint64(uint64(len(x.Name)))
+	h.WriteString(string(x.Name))
+	h.WriteUint64(uint64(x.Num))
+	h.WriteUint64(uint64(x.Small))
+	h.WriteUint64(uint64(x.Unsign))
+	if x.Float == 0 {
+		h.WriteUint64(0)
+	} else {
+		h.WriteUint64(math.Float64bits(x.Float))
+	}
+	if x.Float32 == 0 {
+		h.WriteUint64(0)
+	} else {
+		h.WriteUint64(math.Float64bits(float64(x.Float32)))
+	}
+	if real(x.Complex) == 0 {
+		h.WriteUint64(0)
+	} else {
+		h.WriteUint64(math.Float64bits(float64(real(x.Complex))))
+	}
+	if imag(x.Complex) == 0 {
+		h.WriteUint64(0)
+	} else {
+		h.WriteUint64(math.Float64bits(float64(imag(x.Complex))))
+	}
+	if x.Flag {
+		h.WriteByte(1)
+	} else {
+		h.WriteByte(0)
+	}
+	h.WriteUint64(uint64(x.Dur))
+	if data, err := x.At.MarshalBinary(); err == nil {
+		h.WriteByte(1)
+		h.WriteUint64(uint64(len(data)))
+		h.Write(data)
+	} else {
+		h.WriteByte(0)
+	}
+}
+
+// Hash implements [xhash.Hashable].
+func (x Collections) Hash(h xhash.Hasher) {
+	h.WriteUint64(uint64(len(x.Ints)))
+	for _, e0 := range x.Ints {
+		h.WriteUint64(uint64(e0))
+	}
+	h.WriteUint64(uint64(len(x.Matrix)))
+	for _, e0 := range x.Matrix {
+		h.WriteUint64(uint64(len(e0)))
+		for _, e1 := range e0 {
+			h.WriteUint64(uint64(len(e1)))
+			h.WriteString(e1)
+		}
+	}
+	h.WriteUint64(uint64(len(x.Data)))
+	h.Write(x.Data)
+	h.WriteUint64(uint64(len(x.Labels)))
+	for _, k0 := range slices.Sorted(maps.Keys(x.Labels)) {
+		h.WriteUint64(uint64(len(k0)))
+		h.WriteString(k0)
+		v0 := x.Labels[k0]
+		h.WriteUint64(uint64(len(v0)))
+		h.WriteString(v0)
+	}
+	h.WriteUint64(uint64(len(x.Nested)))
+	for _, k0 := range slices.Sorted(maps.Keys(x.Nested)) {
+		h.WriteUint64(uint64(k0))
+		v0 := x.Nested[k0]
+		h.WriteUint64(uint64(len(v0)))
+		for _, e1 := range v0 {
+			h.WriteUint64(uint64(len(e1)))
+			h.WriteString(string(e1))
+		}
+	}
+	if x.Ptr == nil {
+		h.WriteByte(0)
+	} else {
+		h.WriteByte(1)
+		h.WriteUint64(uint64(*x.Ptr))
+	}
+	if x.PtrPtr == nil {
+		h.WriteByte(0)
+	} else {
+		h.WriteByte(1)
+		if *x.PtrPtr == nil {
+			h.WriteByte(0)
+		} else {
+			h.WriteByte(1)
+			h.WriteUint64(uint64(len(**x.PtrPtr)))
+			h.WriteString(**x.PtrPtr)
+		}
+	}
+	for i0 := range x.Array {
+		h.WriteUint64(uint64(len(x.Array[i0])))
+		h.Write(x.Array[i0])
+	}
+	h.WriteUint64(uint64(len(x.Inner.Tags)))
+	for _, e0 := range x.Inner.Tags {
+		h.WriteUint64(uint64(len(e0)))
+		h.WriteString(e0)
+	}
+	h.WriteUint64(uint64(len(x.Inner.Name)))
+	h.WriteString(x.Inner.Name)
+	if x.InnerPtr == nil {
+		h.WriteByte(0)
+	} else {
+		h.WriteByte(1)
+		h.WriteUint64(uint64(len(x.InnerPtr.Tags)))
+		for _, e1 := range x.InnerPtr.Tags {
+			h.WriteUint64(uint64(len(e1)))
+			h.WriteString(e1)
+		}
+		h.WriteUint64(uint64(len(x.InnerPtr.Name)))
+		h.WriteString(x.InnerPtr.Name)
+	}
+	h.WriteUint64(uint64(len(x.Children)))
+	for _, e0 := range x.Children {
+		if e0 == nil {
+			h.WriteByte(0)
+		} else {
+			h.WriteByte(1)
+			e0.Hash(h)
+		}
+	}
+	h.WriteUint64(uint64(len(x.Values)))
+	for _, e0 := range x.Values {
+		e0.Hash(h)
+	}
+	x.Custom.Hash(h)
+	h.WriteInterface(x.Any)
+	h.WriteInterface(x.Func)
+	h.WriteUint64(uint64(len(x.unexported)))
+	for _, e0 := range x.unexported {
+		h.WriteUint64(uint64(len(e0)))
+		h.WriteString(e0)
+	}
+}
+
+// Hash implements [xhash.Hashable].
+func (x Optional) Hash(h xhash.Hasher) {
+	if v0, ok := x.Name.Deconstruct(); ok {
+		h.WriteByte(1)
+		h.WriteUint64(uint64(len(v0)))
+		h.WriteString(v0)
+	} else {
+		h.WriteByte(0)
+	}
+	if v0, ok := x.Tags.Deconstruct(); ok {
+		h.WriteByte(1)
+		h.WriteUint64(uint64(len(v0)))
+		for _, e1 := range v0 {
+			h.WriteUint64(uint64(len(e1)))
+			h.WriteString(e1)
+		}
+	} else {
+		h.WriteByte(0)
+	}
+	if v0, ok := x.Basic.Deconstruct(); ok {
+		h.WriteByte(1)
+		if v0 == nil {
+			h.WriteByte(0)
+		} else {
+			h.WriteByte(1)
+			v0.Hash(h)
+		}
+	} else {
+		h.WriteByte(0)
+	}
+	if v0, ok := x.Nested.Deconstruct(); ok {
+		h.WriteByte(1)
+		if v1, ok := v0.Deconstruct(); ok {
+			h.WriteByte(1)
+			h.WriteUint64(uint64(len(v1)))
+			for _, k2 := range slices.Sorted(maps.Keys(v1)) {
+				h.WriteUint64(uint64(len(k2)))
+				h.WriteString(k2)
+				v2 := v1[k2]
+				h.WriteUint64(uint64(v2))
+			}
+		} else {
+			h.WriteByte(0)
+		}
+	} else {
+		h.WriteByte(0)
+	}
+	if x.Marker.IsSome() {
+		h.WriteByte(1)
+	} else {
+		h.WriteByte(0)
+	}
+}
+
+// Hash implements [xhash.Hashable].
+func (x Node) Hash(h xhash.Hasher) {
+	h.WriteUint64(uint64(x.Value))
+	if x.Next == nil {
+		h.WriteByte(0)
+	} else {
+		h.WriteByte(1)
+		x.Next.Hash(h)
+	}
+	h.WriteUint64(uint64(len(x.Children)))
+	for _, e0 := range x.Children {
+		e0.Hash(h)
+	}
+}
+
+// Hash implements [xhash.Hashable].
+func (x Tagged) Hash(h xhash.Hasher) {
+	h.WriteUint64(uint64(len(x.Key)))
+	h.WriteString(x.Key)
+}
+
+// Hash implements [xhash.Hashable].
+func (x Pair) Hash(h xhash.Hasher) {
+	h.WriteUint64(uint64(len(x.A)))
+	h.WriteString(x.A)
+	h.WriteUint64(uint64(len(x.B)))
+	h.WriteString(x.B)
+}
+
+var (
+	_ xhash.Hashable = Basic{}
+	_ xhash.Hashable = Collections{}
+	_ xhash.Hashable = Optional{}
+	_ xhash.Hashable = Node{}
+	_ xhash.Hashable = Tagged{}
+	_ xhash.Hashable = Pair{}
+)
@@ -0,0 +1,125 @@
+package testpkg
+
+import (
+	"time"
+
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/std/xhash"
+)
+
+// Name is a named string type.
+type Name string
+
+// Basic holds scalar values only.
+//
+//typact:hash
+type Basic struct {
+	Str     string
+	Name    Name
+	Num     int64
+	Small   int8
+	Unsign  uint
+	Float   float64
+	Float32 float32
+	Complex complex64
+	Flag    bool
+	Dur     time.Duration
+	At      time.Time
+}
+
+// Collections holds values which are written with a length prefix.
+//
+//typact:hash
+type Collections struct {
+	Ints     []int
+	Matrix   [][]string
+	Data     []byte
+	Labels   map[string]string
+	Nested   map[int][]Name
+	Ptr      *int
+	PtrPtr   **string
+	Array    [2][]byte
+	Inner    Inner
+	InnerPtr *Inner
+	Children []*Basic
+	Values   []Basic
+	Custom   Custom
+	Any      any
+	Func     func() string
+
+	unexported []string
+}
+
+// Inner is an unannotated struct.
+type Inner struct {
+	Tags []string
+	Name string
+}
+
+// Optional holds Option values.
+//
+//typact:hash
+type Optional struct {
+	Name   typact.Option[string]
+	Tags   typact.Option[[]string]
+	Basic  typact.Option[*Basic]
+	Nested typact.Option[typact.Option[map[string]int]]
+	Marker typact.Option[struct{}]
+}
+
+// Node is a recursive type.
+//
+//typact:hash
+type Node struct {
+	Value    int
+	Next     *Node
+	Children []Node
+}
+
+// Custom implements xhash.Hashable with a pointer receiver.
+type Custom struct {
+	ID int
+}
+
+// Hash implements xhash.Hashable.
+func (c *Custom) Hash(h xhash.Hasher) {
+	h.WriteInt(c.ID)
+}
+
+// Tagged uses struct tags to control the hashing.
+//
+//typact:hash
+type Tagged struct {
+	Key    string
+	Cache  map[string][]byte `hash:"-"`
+	Parent *Tagged           `hash:"-"`
+	Empty  struct{}
+}
+
+// Pair holds two consecutive strings.
+//
+//typact:hash
+type Pair struct {
+	A string
+	B string
+}
+
+// UnorderedKey has a map with a key type which cannot be sorted.
+type UnorderedKey struct {
+	Lookup map[[2]int]string
+}
+
+// InvalidTag uses an invalid hash tag.
+type InvalidTag struct {
+	Val int `hash:"skip"`
+}
+
+// Recursive is a recursive type which is not annotated.
+type Recursive struct {
+	Children []Recursive
+}
+
+// HasRecursive references an unannotated recursive type.
+type HasRecursive struct {
+	Tree Recursive
+}
@@ -0,0 +1,79 @@
+// Command typact-hashgen generates Hash methods implementing [xhash.Hashable]
+// for structs.
+//
+// A struct is selected by annotating it with the "//typact:hash" directive
+// or by passing its name to the -type flag:
+//
+//	//typact:hash
+//	type MyData struct {
+//		ID   int
+//		Tags []string
+//	}
+//
+// The generated method uses a value receiver and writes all fields into the
+// [xhash.Hasher] without using reflection:
+//
+//	func (x MyData) Hash(h xhash.Hasher)
+//
+// The written data is prefix-free: strings, slices and maps are prefixed with
+// their length, pointers and [typact.Option] values with whether they hold a value.
+// Map entries are written sorted by their key, thus the key type must be ordered.
+// Values of types implementing [xhash.Hashable] are hashed by calling their Hash method.
+// Interfaces, channels and functions are hashed using [xhash.Hasher.WriteInterface].
+//
+// Structs of other packages with unexported fields must either implement
+// [xhash.Hashable] or [encoding.BinaryMarshaler], e.g. [time.Time].
+//
+// A field can be excluded from the hash with the "hash" struct tag:
+//
+//	type MyData struct {
+//		cache []string `hash:"-"`
+//	}
+//
+// Usage with go:generate:
+//
+//	//go:generate go run go.l0nax.org/typact/cmd/typact-hashgen
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const toolName = "typact-hashgen"
+
+func main() {
+	var (
+		dir      = flag.String("dir", ".", "directory of the package")
+		output   = flag.String("output", "typact_hash.go", "name of the generated file, relative to -dir")
+		typeList = flag.String("type", "", "comma-separated list of additional type names")
+	)
+
+	flag.Parse()
+
+	var extra []string
+	if *typeList != "" {
+		extra = strings.Split(*typeList, ",")
+	}
+
+	if err := run(*dir, *output, extra); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", toolName, err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, output string, extra []string) error {
+	src, err := generate(dir, output, extra)
+	if err != nil {
+		return err
+	}
+
+	if src == nil {
+		return fmt.Errorf("no types found: annotate a struct with %q or use -type", directive)
+	}
+
+	return os.WriteFile(filepath.Join(dir, output), src, 0o644)
+}
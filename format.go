@@ -0,0 +1,104 @@
+package typact
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Format implements the [fmt.Formatter] interface.
+//
+// The verbs are handled as follows:
+//
+//   - %v, %+v and %s: "Some(<value>)" or "None", see [Option.String].
+//     The value is formatted with the same verb and flags.
+//   - %#v: valid Go syntax, i.e. "typact.Some[int](5)" or "typact.None[int]()".
+//   - Any other verb, e.g. %d or %q, is passed through to the value if it is [Some].
+//     [None] is always formatted as "None".
+func (o Option[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprint(f, o.GoString())
+		return
+	}
+
+	if !o.some {
+		fmt.Fprint(f, "None")
+		return
+	}
+
+	switch verb {
+	case 'v', 's':
+		fmt.Fprintf(f, "Some("+fmt.FormatString(f, verb)+")", o.val)
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), o.val)
+	}
+}
+
+// GoString implements the [fmt.GoStringer] interface.
+// It returns o as valid Go syntax, e.g. "typact.Some[int](5)".
+func (o Option[T]) GoString() string {
+	typ := reflect.TypeFor[T]().String()
+
+	if !o.some {
+		return "typact.None[" + typ + "]()"
+	}
+
+	return fmt.Sprintf("typact.Some[%s](%#v)", typ, o.val)
+}
+
+// ErrInvalidOptionString is returned by [ParseOption] if the input
+// is not in the format produced by [Option.String] or [Option.GoString].
+var ErrInvalidOptionString = errors.New("invalid Option string")
+
+// ParseOption parses the output of [Option.String], e.g. "Some(5)" or
+// "None", back into an [Option]. This allows to use the debug output in
+// golden-file tests.
+//
+// The Go syntax produced by [Option.GoString] is accepted as well, as
+// long as the value is formatted as a scalar literal, e.g. "typact.Some[string]("foo")".
+//
+// The value is parsed using [Option.UnmarshalText], thus T must
+// be a scalar type or implement [encoding.TextUnmarshaler].
+func ParseOption[T any](s string) (Option[T], error) {
+	inner, some, goSyntax, err := splitOption(s, reflect.TypeFor[T]().String())
+	if err != nil {
+		return None[T](), err
+	}
+
+	if !some {
+		return None[T](), nil
+	}
+
+	if goSyntax && strings.HasPrefix(inner, `"`) {
+		if unquoted, err := strconv.Unquote(inner); err == nil {
+			inner = unquoted
+		}
+	}
+
+	var ret Option[T]
+	if err := ret.UnmarshalText([]byte(inner)); err != nil {
+		return None[T](), fmt.Errorf("%w: %q: %w", ErrInvalidOptionString, s, err)
+	}
+
+	return ret, nil
+}
+
+// splitOption splits the string representation s of an [Option] of
+// the type typ into its value. some reports whether s is [Some],
+// goSyntax whether s is in the format of [Option.GoString].
+func splitOption(s, typ string) (inner string, some, goSyntax bool, err error) {
+	switch s {
+	case "None", "typact.None[" + typ + "]()":
+		return "", false, false, nil
+	}
+
+	for _, prefix := range []string{"Some(", "typact.Some[" + typ + "]("} {
+		if strings.HasPrefix(s, prefix) && strings.HasSuffix(s, ")") {
+			return s[len(prefix) : len(s)-1], true, prefix != "Some(", nil
+		}
+	}
+
+	return "", false, false, fmt.Errorf("%w: %q", ErrInvalidOptionString, s)
+}
@@ -1,3 +1,3 @@
 module go.l0nax.org/typact
 
-go 1.22.3
+go 1.23.1
@@ -0,0 +1,169 @@
+package hashlint
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const xhashPath = "go.l0nax.org/typact/std/xhash"
+
+// Analyzer reports consecutive [xhash.Hasher.WriteString] calls without
+// a length prefix in Hash methods.
+var Analyzer = &analysis.Analyzer{
+	Name:     "typacthash",
+	Doc:      "report Hash methods writing consecutive strings without length prefix",
+	URL:      "https://pkg.go.dev/go.l0nax.org/typact/hashlint",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	insp.Preorder([]ast.Node{(*ast.FuncDecl)(nil)}, func(n ast.Node) {
+		fn := n.(*ast.FuncDecl)
+		if fn.Recv == nil || fn.Body == nil || fn.Name.Name != "Hash" || !isHashMethod(pass, fn) {
+			return
+		}
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.BlockStmt:
+				checkStmts(pass, n.List)
+			case *ast.CaseClause:
+				checkStmts(pass, n.Body)
+			case *ast.CommClause:
+				checkStmts(pass, n.Body)
+			}
+
+			return true
+		})
+	})
+
+	return nil, nil
+}
+
+// checkStmts reports all consecutive WriteString calls in list
+// where the first string is neither length-prefixed nor constant.
+func checkStmts(pass *analysis.Pass, list []ast.Stmt) {
+	for i := 1; i < len(list); i++ {
+		prev := hasherCall(pass, list[i-1], "WriteString")
+		cur := hasherCall(pass, list[i], "WriteString")
+
+		if prev == nil || cur == nil {
+			continue
+		}
+
+		arg := prev.Args[0]
+		if tv, ok := pass.TypesInfo.Types[arg]; ok && tv.Value != nil {
+			// constants have a fixed length
+			continue
+		}
+
+		if i >= 2 && isLengthPrefix(pass, list[i-2], arg) {
+			continue
+		}
+
+		pass.Reportf(cur.Pos(),
+			"consecutive WriteString calls without length prefix are not prefix-free, write len(%s) first",
+			types.ExprString(arg))
+	}
+}
+
+// isLengthPrefix reports whether stmt writes the length of str,
+// e.g. h.WriteInt(len(str)) or h.WriteUint64(uint64(len(str))).
+func isLengthPrefix(pass *analysis.Pass, stmt ast.Stmt, str ast.Expr) bool {
+	call := hasherCall(pass, stmt, "WriteInt")
+	if call == nil {
+		call = hasherCall(pass, stmt, "WriteUint64")
+	}
+
+	if call == nil {
+		return false
+	}
+
+	want := types.ExprString(str)
+	found := false
+
+	ast.Inspect(call.Args[0], func(n ast.Node) bool {
+		c, ok := n.(*ast.CallExpr)
+		if !ok || len(c.Args) != 1 {
+			return !found
+		}
+
+		if id, ok := c.Fun.(*ast.Ident); ok && id.Name == "len" && types.ExprString(c.Args[0]) == want {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// hasherCall returns the call of the [xhash.Hasher] method name in stmt, if any.
+// Both, "h.WriteString(s)" and "_, _ = h.WriteString(s)" are supported.
+func hasherCall(pass *analysis.Pass, stmt ast.Stmt, name string) *ast.CallExpr {
+	var expr ast.Expr
+
+	switch stmt := stmt.(type) {
+	case *ast.ExprStmt:
+		expr = stmt.X
+	case *ast.AssignStmt:
+		if len(stmt.Rhs) != 1 {
+			return nil
+		}
+
+		expr = stmt.Rhs[0]
+	default:
+		return nil
+	}
+
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return nil
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != name {
+		return nil
+	}
+
+	if !isHasher(pass.TypesInfo.TypeOf(sel.X)) {
+		return nil
+	}
+
+	return call
+}
+
+// isHashMethod reports whether fn is the method Hash(xhash.Hasher).
+func isHashMethod(pass *analysis.Pass, fn *ast.FuncDecl) bool {
+	obj, ok := pass.TypesInfo.Defs[fn.Name].(*types.Func)
+	if !ok {
+		return false
+	}
+
+	sig := obj.Type().(*types.Signature)
+
+	return sig.Params().Len() == 1 && sig.Results().Len() == 0 && isHasher(sig.Params().At(0).Type())
+}
+
+// isHasher reports whether typ is [xhash.Hasher].
+func isHasher(typ types.Type) bool {
+	if typ == nil {
+		return false
+	}
+
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == xhashPath && obj.Name() == "Hasher"
+}
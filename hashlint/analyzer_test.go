@@ -0,0 +1,13 @@
+package hashlint_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"go.l0nax.org/typact/hashlint"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), hashlint.Analyzer, "a")
+}
@@ -0,0 +1,17 @@
+// Command typact-hashlint reports hand-written Hash methods which are
+// not prefix-free, see [hashlint.Analyzer].
+//
+// Usage:
+//
+//	go run go.l0nax.org/typact/hashlint/cmd/typact-hashlint ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"go.l0nax.org/typact/hashlint"
+)
+
+func main() {
+	singlechecker.Main(hashlint.Analyzer)
+}
@@ -0,0 +1,21 @@
+// Package hashlint provides an analyzer which reports hand-written
+// [xhash.Hashable] implementations that are not prefix-free.
+//
+// A Hash method which writes two strings with consecutive calls of
+// [xhash.Hasher.WriteString] produces the same hash for the tuples
+// ("ab", "c") and ("a", "bc"). The analyzer reports such calls, unless the
+// first string is prefixed with its length or is a constant:
+//
+//	func (p Pair) Hash(h xhash.Hasher) {
+//		h.WriteInt(len(p.A))
+//		h.WriteString(p.A)
+//		h.WriteString(p.B)
+//	}
+//
+// Use the typact-hashgen generator to avoid writing Hash methods by hand.
+// The analyzer can be run using the typact-hashlint command:
+//
+//	go run go.l0nax.org/typact/hashlint/cmd/typact-hashlint ./...
+//
+// This package lives in its own module so the core of typact stays free of dependencies.
+package hashlint
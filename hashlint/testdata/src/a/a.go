@@ -0,0 +1,84 @@
+package a
+
+import "go.l0nax.org/typact/std/xhash"
+
+type Pair struct {
+	A, B string
+}
+
+func (p Pair) Hash(h xhash.Hasher) {
+	h.WriteString(p.A)
+	h.WriteString(p.B) // want `consecutive WriteString calls without length prefix are not prefix-free, write len\(p.A\) first`
+}
+
+type Ignored struct {
+	A, B string
+}
+
+func (p Ignored) Hash(h xhash.Hasher) {
+	_, _ = h.WriteString(p.A)
+	_, _ = h.WriteString(p.B) // want `write len\(p.A\) first`
+}
+
+type Prefixed struct {
+	A, B, C string
+}
+
+func (p Prefixed) Hash(h xhash.Hasher) {
+	h.WriteInt(len(p.A))
+	h.WriteString(p.A)
+	h.WriteUint64(uint64(len(p.B)))
+	h.WriteString(p.B)
+	h.WriteString(p.C)
+}
+
+type Constant struct {
+	A string
+}
+
+func (c Constant) Hash(h xhash.Hasher) {
+	h.WriteString("Constant")
+	h.WriteString(c.A)
+}
+
+type Nested struct {
+	A, B string
+	Ok   bool
+}
+
+func (n Nested) Hash(h xhash.Hasher) {
+	if n.Ok {
+		h.WriteString(n.A)
+		h.WriteString(n.B) // want `write len\(n.A\) first`
+	}
+
+	switch {
+	case n.A == "":
+		h.WriteInt(len(n.B))
+		h.WriteString(n.A) // wrong length prefix
+		h.WriteString(n.B) // want `write len\(n.A\) first`
+	}
+}
+
+type Separated struct {
+	A, B string
+}
+
+func (s Separated) Hash(h xhash.Hasher) {
+	h.WriteString(s.A)
+	h.WriteByte(0)
+	h.WriteString(s.B)
+}
+
+type Other struct{}
+
+// Hash is not an xhash.Hashable implementation.
+func (Other) Hash(w interface{ WriteString(string) (int, error) }) {
+	w.WriteString("a")
+	w.WriteString("b")
+}
+
+func notAMethod(h xhash.Hasher, a, b string) {
+	h.WriteString(a)
+	h.WriteString(b)
+}
@@ -0,0 +1,9 @@
+// Package xhash is a stub of go.l0nax.org/typact/std/xhash.
+package xhash
+
+type Hasher interface {
+	WriteByte(b byte) error
+	WriteString(s string) (int, error)
+	WriteInt(n int)
+	WriteUint64(n uint64)
+}
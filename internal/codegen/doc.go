@@ -0,0 +1,4 @@
+// Package codegen provides the shared functionality of the typact
+// code generators, i.e. loading and type-checking the input package
+// and writing the generated file.
+package codegen
@@ -0,0 +1,149 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/types"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// File is a generated Go file.
+type File struct {
+	pkg     *types.Package
+	imports map[string]string // path => name
+	aliased map[string]bool   // path => whether the import needs an alias
+	body    bytes.Buffer
+}
+
+// NewFile returns a new [File] for pkg.
+func NewFile(pkg *types.Package) *File {
+	return &File{
+		pkg:     pkg,
+		imports: make(map[string]string),
+		aliased: make(map[string]bool),
+	}
+}
+
+// Printf writes the formatted string into the body of f.
+func (f *File) Printf(format string, args ...any) {
+	fmt.Fprintf(&f.body, format, args...)
+}
+
+// Import adds the package with pkgPath and pkgName to the imports of f
+// and returns the name to reference it.
+func (f *File) Import(pkgPath, pkgName string) string {
+	if name, ok := f.imports[pkgPath]; ok {
+		return name
+	}
+
+	// resolve name collisions
+	name := pkgName
+	for i := 2; f.isNameUsed(name); i++ {
+		name = pkgName + strconv.Itoa(i)
+	}
+
+	f.imports[pkgPath] = name
+	f.aliased[pkgPath] = name != path.Base(pkgPath)
+
+	return name
+}
+
+// TypeString returns the string representation of typ which
+// can be used in the generated file.
+func (f *File) TypeString(typ types.Type) string {
+	return types.TypeString(typ, f.qualifier)
+}
+
+// qualifier implements [types.Qualifier].
+func (f *File) qualifier(pkg *types.Package) string {
+	if pkg == f.pkg {
+		return ""
+	}
+
+	return f.Import(pkg.Path(), pkg.Name())
+}
+
+func (f *File) isNameUsed(name string) bool {
+	for _, n := range f.imports {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Bytes returns the formatted source of f.
+// tool is the name of the generator, which is written into the header.
+func (f *File) Bytes(tool string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "// Code generated by %s. DO NOT EDIT.\n\n", tool)
+	fmt.Fprintf(&buf, "package %s\n\n", f.pkg.Name())
+
+	if len(f.imports) > 0 {
+		paths := make([]string, 0, len(f.imports))
+		for p := range f.imports {
+			paths = append(paths, p)
+		}
+
+		slices.Sort(paths)
+
+		// standard library packages are grouped first
+		slices.SortStableFunc(paths, func(a, b string) int {
+			switch aStd, bStd := isStdlib(a), isStdlib(b); {
+			case aStd && !bStd:
+				return -1
+			case !aStd && bStd:
+				return 1
+			}
+
+			return 0
+		})
+
+		buf.WriteString("import (\n")
+		for i, p := range paths {
+			if i > 0 && isStdlib(paths[i-1]) != isStdlib(p) {
+				buf.WriteString("\n")
+			}
+
+			if f.aliased[p] {
+				fmt.Fprintf(&buf, "%s %q\n", f.imports[p], p)
+			} else {
+				fmt.Fprintf(&buf, "%q\n", p)
+			}
+		}
+		buf.WriteString(")\n\n")
+	}
+
+	buf.Write(f.body.Bytes())
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("unable to format generated code: %w\n%s", err, buf.Bytes())
+	}
+
+	return src, nil
+}
+
+// isStdlib reports whether pkgPath is a package of the standard library,
+// i.e. its first path element does not contain a dot.
+func isStdlib(pkgPath string) bool {
+	first, _, _ := strings.Cut(pkgPath, "/")
+	return !strings.Contains(first, ".")
+}
+
+// WriteFile writes the formatted source of f to name.
+func (f *File) WriteFile(name, tool string) error {
+	src, err := f.Bytes(tool)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(name, src, 0o644)
+}
@@ -0,0 +1,148 @@
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Package holds a parsed and type-checked Go package.
+type Package struct {
+	// Dir is the absolute directory of the package.
+	Dir string
+	// Name is the package name.
+	Name string
+
+	Fset  *token.FileSet
+	Files []*ast.File
+	Types *types.Package
+	Info  *types.Info
+}
+
+// Load parses and type-checks the Go package in dir.
+// Test files and all files listed in exclude are skipped.
+//
+// Type errors are ignored, because the package may reference code
+// which has not been generated yet.
+// Use [Package.Lookup] to ensure that the required types are valid.
+func Load(dir string, exclude ...string) (*Package, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve directory: %w", err)
+	}
+
+	bp, err := build.ImportDir(absDir, 0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load package: %w", err)
+	}
+
+	pkg := &Package{
+		Dir:  absDir,
+		Name: bp.Name,
+		Fset: token.NewFileSet(),
+		Info: &types.Info{
+			Types: make(map[ast.Expr]types.TypeAndValue),
+			Defs:  make(map[*ast.Ident]types.Object),
+			Uses:  make(map[*ast.Ident]types.Object),
+		},
+	}
+
+	for _, name := range bp.GoFiles {
+		if slices.Contains(exclude, name) {
+			continue
+		}
+
+		file, err := parser.ParseFile(pkg.Fset, filepath.Join(absDir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse file: %w", err)
+		}
+
+		pkg.Files = append(pkg.Files, file)
+	}
+
+	conf := types.Config{
+		Importer: importer.ForCompiler(pkg.Fset, "source", nil),
+		// ignore all errors, see the function documentation.
+		Error: func(error) {},
+	}
+
+	path := bp.ImportPath
+	if path == "" || path == "." {
+		path = bp.Name
+	}
+
+	pkg.Types, _ = conf.Check(path, pkg.Fset, pkg.Files, pkg.Info)
+
+	return pkg, nil
+}
+
+// Lookup returns the named struct type name.
+func (p *Package) Lookup(name string) (*types.TypeName, error) {
+	obj, ok := p.Types.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("type %s not found in package %s", name, p.Name)
+	}
+
+	if _, ok := obj.Type().Underlying().(*types.Struct); !ok {
+		return nil, fmt.Errorf("type %s is not a struct", name)
+	}
+
+	if named, ok := obj.Type().(*types.Named); ok && named.TypeParams().Len() > 0 {
+		return nil, fmt.Errorf("type %s: generic types are not supported", name)
+	}
+
+	return obj, nil
+}
+
+// Annotated returns the names of all types annotated with the given
+// directive, e.g. "//typact:clone", in source order.
+func (p *Package) Annotated(directive string) []string {
+	var ret []string
+
+	for _, file := range p.Files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+
+				// a directive on a single type declaration is attached to the GenDecl.
+				doc := ts.Doc
+				if doc == nil && len(gen.Specs) == 1 {
+					doc = gen.Doc
+				}
+
+				if hasDirective(doc, directive) {
+					ret = append(ret, ts.Name.Name)
+				}
+			}
+		}
+	}
+
+	return ret
+}
+
+// hasDirective reports whether doc contains directive.
+func hasDirective(doc *ast.CommentGroup, directive string) bool {
+	if doc == nil {
+		return false
+	}
+
+	for _, c := range doc.List {
+		if strings.TrimSpace(c.Text) == directive {
+			return true
+		}
+	}
+
+	return false
+}
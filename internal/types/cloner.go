@@ -0,0 +1,29 @@
+package types
+
+import "reflect"
+
+// DeepCloner is the state of an active deep clone of the
+// std/clone package.
+type DeepCloner interface {
+	// CloneValue returns a deep copy of src using the configuration,
+	// the visited pointers and the depth of the active clone.
+	CloneValue(src reflect.Value) (reflect.Value, error)
+}
+
+// StateCloner clones values of types which must be cloned
+// using the state of the active deep clone, e.g. to support
+// cyclic pointer graphs.
+type StateCloner interface {
+	// Handles reports whether values of typ are cloned by the StateCloner.
+	Handles(typ reflect.Type) bool
+
+	// CloneUsing returns a deep copy of src, whereas all
+	// nested values are cloned using c.
+	CloneUsing(src reflect.Value, c DeepCloner) (reflect.Value, error)
+}
+
+// StateCloneHook is the [StateCloner] used by the std/clone package.
+//
+// It is set by the typact package, which allows to clone typact.Option
+// without exporting the required methods.
+var StateCloneHook StateCloner
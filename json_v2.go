@@ -0,0 +1,139 @@
+//go:build go1.27 && goexperiment.jsonv2
+// +build go1.27,goexperiment.jsonv2
+
+// NOTE: Although encoding/json/v2 is available since Go 1.25 with
+// GOEXPERIMENT=jsonv2, its API is only part of the standard library
+// since Go 1.27. Older API versions are rejected by "go vet" (stdversion)
+// and are not covered by the compatibility promise.
+
+package typact
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+
+	"go.l0nax.org/typact/internal/types"
+)
+
+// MarshalJSONTo implements the [jsonv2.MarshalerTo] interface.
+// If value is not present, 'null' be encoded.
+//
+// The value is encoded directly into enc, thus no intermediate
+// buffer is allocated. With encoding/json/v2 the "omitempty" tag
+// omits [None], since it is encoded as 'null'.
+func (o Option[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !o.some {
+		return enc.WriteToken(jsontext.Null)
+	}
+
+	return jsonv2.MarshalEncode(enc, o.val)
+}
+
+// UnmarshalJSONFrom implements the [jsonv2.UnmarshalerFrom] interface.
+func (o *Option[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	// reset first
+	o.some = false
+
+	if dec.PeekKind() == 'n' {
+		// only allocate in slow path
+		o.val = types.ZeroValue[T]()
+
+		_, err := dec.ReadToken()
+
+		return err
+	}
+
+	var val T
+
+	if err := jsonv2.UnmarshalDecode(dec, &val); err != nil {
+		// only allocate in slow path
+		o.val = types.ZeroValue[T]()
+
+		return err
+	}
+
+	o.val = val
+	o.some = true
+
+	return nil
+}
+
+// MarshalJSONTo implements the [jsonv2.MarshalerTo] interface.
+//
+// Both, [Unset] and [Null] are encoded as 'null'.
+func (n Nullable[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if n.state != nullableValue {
+		return enc.WriteToken(jsontext.Null)
+	}
+
+	return jsonv2.MarshalEncode(enc, n.val)
+}
+
+// UnmarshalJSONFrom implements the [jsonv2.UnmarshalerFrom] interface.
+//
+// 'null' is decoded as [Null], any other value as [Value].
+func (n *Nullable[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if dec.PeekKind() == 'n' {
+		// only allocate in slow path
+		n.val = types.ZeroValue[T]()
+		n.state = nullableNull
+
+		_, err := dec.ReadToken()
+
+		return err
+	}
+
+	var val T
+
+	if err := jsonv2.UnmarshalDecode(dec, &val); err != nil {
+		// only allocate in slow path
+		n.val = types.ZeroValue[T]()
+		n.state = nullableUnset
+
+		return err
+	}
+
+	n.val = val
+	n.state = nullableValue
+
+	return nil
+}
+
+// MarshalJSONTo implements the [jsonv2.MarshalerTo] interface.
+// If value is not present, 'null' be encoded.
+func (o OmitNone[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if len(o) == 0 {
+		return enc.WriteToken(jsontext.Null)
+	}
+
+	return jsonv2.MarshalEncode(enc, o[0])
+}
+
+// UnmarshalJSONFrom implements the [jsonv2.UnmarshalerFrom] interface.
+func (o *OmitNone[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	// reset first
+	*o = nil
+
+	if dec.PeekKind() == 'n' {
+		_, err := dec.ReadToken()
+		return err
+	}
+
+	var val T
+	if err := jsonv2.UnmarshalDecode(dec, &val); err != nil {
+		return err
+	}
+
+	*o = OmitNone[T]{val}
+
+	return nil
+}
+
+var (
+	_ jsonv2.MarshalerTo     = Option[int]{}
+	_ jsonv2.UnmarshalerFrom = (*Option[int])(nil)
+	_ jsonv2.MarshalerTo     = Nullable[int]{}
+	_ jsonv2.UnmarshalerFrom = (*Nullable[int])(nil)
+	_ jsonv2.MarshalerTo     = OmitNone[int]{}
+	_ jsonv2.UnmarshalerFrom = (*OmitNone[int])(nil)
+)
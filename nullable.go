@@ -0,0 +1,203 @@
+package typact
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"go.l0nax.org/typact/internal/types"
+)
+
+// nullableState holds the state of a [Nullable].
+type nullableState uint8
+
+const (
+	nullableUnset nullableState = iota
+	nullableNull
+	nullableValue
+)
+
+// Nullable represents a tri-state value: it is either unset ([Unset]),
+// explicitly set to null ([Null]) or set to a value ([Value]).
+//
+// In contrast to [Option], it allows to distinguish a missing key from
+// an explicit null when decoding JSON, which is required to implement
+// PATCH endpoints, e.g. using JSON Merge Patch (RFC 7396).
+//
+// The zero value of [Nullable] is [Unset].
+//
+// NOTE: encoding/json does not call UnmarshalJSON for missing keys,
+// thus always decode into a fresh value to not retain a previous state.
+type Nullable[T any] struct {
+	val   T
+	state nullableState
+}
+
+// Unset returns an unset [Nullable], i.e. the value is absent.
+//
+//gcassert:inline
+func Unset[T any]() Nullable[T] {
+	return Nullable[T]{}
+}
+
+// Null returns a [Nullable] which is explicitly set to null.
+//
+//gcassert:inline
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{
+		state: nullableNull,
+	}
+}
+
+// Value returns a [Nullable] which is set to val.
+//
+//gcassert:inline
+func Value[T any](val T) Nullable[T] {
+	return Nullable[T]{
+		val:   val,
+		state: nullableValue,
+	}
+}
+
+// NullableFromOption converts o into a [Nullable].
+// It returns [Value] if o is [Some], otherwise [Null].
+func NullableFromOption[T any](o Option[T]) Nullable[T] {
+	if o.some {
+		return Value(o.val)
+	}
+
+	return Null[T]()
+}
+
+// IsZero returns whether n is [Unset].
+//
+// NOTE: This method allows to omit unset values when
+// using the "omitzero" tag of encoding/json (Go 1.24+).
+func (n Nullable[T]) IsZero() bool {
+	return n.state == nullableUnset
+}
+
+// IsUnset returns true if n is [Unset].
+//
+//gcassert:inline
+func (n Nullable[T]) IsUnset() bool {
+	return n.state == nullableUnset
+}
+
+// IsNull returns true if n is explicitly set to null.
+//
+//gcassert:inline
+func (n Nullable[T]) IsNull() bool {
+	return n.state == nullableNull
+}
+
+// IsValue returns true if n is set to a value.
+//
+//gcassert:inline
+func (n Nullable[T]) IsValue() bool {
+	return n.state == nullableValue
+}
+
+// IsSet returns true if n is either [Null] or set to a value.
+//
+//gcassert:inline
+func (n Nullable[T]) IsSet() bool {
+	return n.state != nullableUnset
+}
+
+// Deconstruct returns the value and whether n is set to a value.
+//
+//gcassert:inline
+func (n Nullable[T]) Deconstruct() (T, bool) {
+	return n.val, n.state == nullableValue
+}
+
+// UnsafeUnwrap returns the value without checking the state of n.
+// If n is not set to a value, the zero value of T is returned.
+//
+//gcassert:inline
+func (n Nullable[T]) UnsafeUnwrap() T {
+	return n.val
+}
+
+// Unwrap returns the value.
+//
+// WARN: This function panics if n is not set to a value!
+func (n Nullable[T]) Unwrap() T {
+	if n.state != nullableValue {
+		panic("called `Nullable.Unwrap()` on a non-value")
+	}
+
+	return n.val
+}
+
+// UnwrapOr returns the value if n is set to a value, otherwise value.
+func (n Nullable[T]) UnwrapOr(value T) T {
+	if n.state == nullableValue {
+		return n.val
+	}
+
+	return value
+}
+
+// Option converts n into an [Option].
+// It returns [Some] if n is set to a value, otherwise [None].
+func (n Nullable[T]) Option() Option[T] {
+	if n.state == nullableValue {
+		return Some(n.val)
+	}
+
+	return None[T]()
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+//
+// Both, [Unset] and [Null] are encoded as 'null'. Use the
+// "omitzero" tag (Go 1.24+) to omit [Unset] values entirely.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.state == nullableValue {
+		return json.Marshal(n.val)
+	}
+
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+//
+// 'null' is decoded as [Null], any other value as [Value].
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		// only allocate in slow path
+		n.val = types.ZeroValue[T]()
+		n.state = nullableNull
+
+		return nil
+	}
+
+	var val T
+
+	if err := json.Unmarshal(data, &val); err != nil {
+		// only allocate in slow path
+		n.val = types.ZeroValue[T]()
+		n.state = nullableUnset
+
+		return err
+	}
+
+	n.val = val
+	n.state = nullableValue
+
+	return nil
+}
+
+// String implements the [fmt.Stringer] interface.
+func (n Nullable[T]) String() string {
+	switch n.state {
+	case nullableNull:
+		return "Null"
+	case nullableValue:
+		return fmt.Sprintf("Value(%v)", n.val)
+	}
+
+	return "Unset"
+}
@@ -0,0 +1,99 @@
+package typact
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// OmitNone wraps an [Option] so that [None] is omitted entirely by
+// encoding/json when used with the "omitempty" tag:
+//
+//	type User struct {
+//		Email typact.OmitNone[string] `json:"email,omitempty"`
+//	}
+//
+// encoding/json never treats a struct, like [Option], as empty, thus
+// [Option.MarshalJSON] always writes 'null' for [None]. Since Go 1.24,
+// the "omitzero" tag can be used with [Option] instead.
+//
+// The zero value of [OmitNone] is [None].
+//
+// NOTE: OmitNone is backed by a slice holding at most one element,
+// which is the only way to be "empty" for encoding/json.
+// Do not modify the underlying slice directly, use [OmitNoneFrom] and
+// [OmitNone.Option] instead.
+type OmitNone[T any] []T
+
+// OmitNoneFrom wraps o into an [OmitNone].
+func OmitNoneFrom[T any](o Option[T]) OmitNone[T] {
+	if !o.some {
+		return nil
+	}
+
+	return OmitNone[T]{o.val}
+}
+
+// Option returns the wrapped [Option].
+func (o OmitNone[T]) Option() Option[T] {
+	if len(o) == 0 {
+		return None[T]()
+	}
+
+	return Some(o[0])
+}
+
+// IsSome returns true if o contains a value.
+//
+//gcassert:inline
+func (o OmitNone[T]) IsSome() bool {
+	return len(o) != 0
+}
+
+// IsNone returns true if o does not contain a value.
+//
+//gcassert:inline
+func (o OmitNone[T]) IsNone() bool {
+	return len(o) == 0
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// If value is not present, 'null' be encoded.
+//
+// NOTE: This method is only called without the "omitempty" tag
+// or if o is [Some].
+func (o OmitNone[T]) MarshalJSON() ([]byte, error) {
+	if len(o) == 0 {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(o[0])
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+func (o *OmitNone[T]) UnmarshalJSON(data []byte) error {
+	// reset first
+	*o = nil
+
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var val T
+	if err := json.Unmarshal(data, &val); err != nil {
+		return err
+	}
+
+	*o = OmitNone[T]{val}
+
+	return nil
+}
+
+// String implements the [fmt.Stringer] interface.
+func (o OmitNone[T]) String() string {
+	if len(o) == 0 {
+		return "None"
+	}
+
+	return fmt.Sprintf("Some(%v)", o[0])
+}
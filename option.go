@@ -7,8 +7,11 @@ import (
 	"encoding"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"math"
+	"reflect"
 	"strconv"
+	"time"
 
 	"go.l0nax.org/typact/internal/types"
 	"go.l0nax.org/typact/std/xhash"
@@ -180,6 +183,16 @@ func (o Option[T]) Inspect(fn func(T)) Option[T] {
 	return o
 }
 
+// Iter returns an iterator over the possibly contained value.
+// The iterator yields exactly one value if o is [Some], otherwise none.
+func (o Option[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.some {
+			yield(o.val)
+		}
+	}
+}
+
 // Inserts val into o and returns the reference to the inserted value.
 // If the option already contains a value, the old value is dropped.
 //
@@ -319,6 +332,30 @@ func (o *Option[T]) Take() Option[T] {
 	return vv
 }
 
+// OkOr transforms o into a [Result], mapping [Some] to [Ok]
+// and [None] to [Err] with err.
+//
+// WARN: This method panics if o is [None] and err is nil!
+func (o Option[T]) OkOr(err error) Result[T] {
+	if o.some {
+		return Ok(o.val)
+	}
+
+	return Err[T](err)
+}
+
+// OkOrElse transforms o into a [Result], mapping [Some] to [Ok]
+// and [None] to [Err] with the error returned by fn.
+//
+// WARN: This method panics if o is [None] and fn returns nil!
+func (o Option[T]) OkOrElse(fn func() error) Result[T] {
+	if o.some {
+		return Ok(o.val)
+	}
+
+	return Err[T](fn())
+}
+
 // Value implements the [driver.Valuer] interface.
 // It returns NULL if o is [None], otherwise it
 // returns the value of o.
@@ -340,6 +377,9 @@ func (o Option[T]) Value() (driver.Value, error) {
 // Scan implements the [sql.Scanner] interface.
 //
 // If *T implements [sql.Scanner], the custom method will be called.
+// Otherwise src is converted using the same rules as [sql.Rows.Scan], e.g.
+// an int64 column can be scanned into Option[int32] and a []byte column
+// into Option[string]. Additionally, strings are parsed into [time.Time].
 func (o *Option[T]) Scan(src any) error {
 	// reset first
 	o.some = false
@@ -365,25 +405,15 @@ func (o *Option[T]) Scan(src any) error {
 		return err
 	}
 
-	av, err := driver.DefaultParameterConverter.ConvertValue(src)
-	if err != nil {
+	if err := convertAssign(&o.val, src); err != nil {
 		// only allocate in slow path
 		// this overrides any previously defined value in the field.
 		o.val = types.ZeroValue[T]()
 
-		// TODO(l0nax): Wrap the returned error and return it!
 		return err
 	}
 
-	v, ok := av.(T)
-	if !ok {
-		// explicitly copy src to prevent heap escape.
-		tmp := src
-		return fmt.Errorf("got unexpected type %T", tmp)
-	}
-
 	o.some = true
-	o.val = v
 
 	return nil
 }
@@ -440,6 +470,58 @@ func (o *Option[T]) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalYAML implements the YAML marshaler interface as defined by
+// gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+// If the value is not present, 'null' will be encoded.
+//
+// If [Some], the value is returned as is, so the encoder encodes it
+// as the native node – including any custom marshaler T implements.
+func (o Option[T]) MarshalYAML() (any, error) {
+	if !o.some {
+		return nil, nil
+	}
+
+	return o.val, nil
+}
+
+// UnmarshalYAML implements the YAML unmarshaler interface as defined by
+// gopkg.in/yaml.v2.
+//
+// NOTE: gopkg.in/yaml.v3 still supports this (obsolete) interface, which
+// allows us to support YAML without depending on any YAML library.
+//
+// WARN: gopkg.in/yaml.v3 does not call any unmarshaler for 'null' nodes.
+// Thus an existing value is NOT reset to [None] and 'null' entries
+// of a sequence are skipped.
+func (o *Option[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	// reset first
+	o.some = false
+
+	// we unmarshal into a pointer to be able to detect 'null' values.
+	var val *T
+
+	if err := unmarshal(&val); err != nil {
+		// only allocate in slow path.
+		// this overrides any previously defined value in the field.
+		o.val = types.ZeroValue[T]()
+
+		return err
+	}
+
+	if val == nil {
+		// only allocate in slow path.
+		// this overrides any previously defined value in the field.
+		o.val = types.ZeroValue[T]()
+
+		return nil
+	}
+
+	o.val = *val
+	o.some = true
+
+	return nil
+}
+
 // MarshalTOML implements a TOML (v1) marshaler.
 //
 // If T is not a scalar value and does not implement the TOMLMarshaler
@@ -469,11 +551,19 @@ func (o Option[T]) MarshalTOML() ([]byte, error) {
 
 	switch val := zz.(type) {
 	case string:
-		raw := `'` + val + `'`
+		raw, err := quoteTOMLString(val)
+		if err != nil {
+			return nil, err
+		}
+
 		return string2Bytes(raw), nil
 
 	case []byte:
-		raw := `'` + string(val) + `'`
+		raw, err := quoteTOMLString(bytes2String(val))
+		if err != nil {
+			return nil, err
+		}
+
 		return string2Bytes(raw), nil
 
 	case int:
@@ -545,11 +635,15 @@ func (o Option[T]) MarshalText() ([]byte, error) {
 
 	if !types.IsScalar[T]() {
 		enc, ok := any(o.val).(encoding.TextMarshaler)
-		if !ok {
-			return nil, fmt.Errorf("type %T does not implement encoding.TextMarshaler", o.val)
+		if ok {
+			return enc.MarshalText()
+		}
+
+		if raw, ok := marshalTextKind(any(o.val)); ok {
+			return raw, nil
 		}
 
-		return enc.MarshalText()
+		return nil, fmt.Errorf("type %T does not implement encoding.TextMarshaler", o.val)
 	}
 
 	// it's a scalar type
@@ -623,18 +717,24 @@ func (o Option[T]) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the [encoding.TextUnmarshaler] interface.
 //
+// Besides scalar types and types implementing [encoding.TextUnmarshaler],
+// [time.Duration] is parsed using [time.ParseDuration] and named types
+// are parsed according to their underlying scalar type.
+//
 // NOTE: when using scalar types, it is advised use the "omitzero" tag!
 func (o *Option[T]) UnmarshalText(data []byte) error {
 	o.some = false
 
 	if !types.IsScalar[T]() {
-		enc, ok := any(o.val).(encoding.TextUnmarshaler)
+		// NOTE: UnmarshalText is usually implemented with a pointer receiver,
+		// thus we have to check the address of the value first.
+		enc, ok := any(&o.val).(encoding.TextUnmarshaler)
 		if !ok {
-			// only allocate in slow path.
-			// this overrides any previously defined value in the field.
-			o.val = types.ZeroValue[T]()
+			enc, ok = any(o.val).(encoding.TextUnmarshaler)
+		}
 
-			return fmt.Errorf("type %T does not implement encoding.TextUnmarshaler", o.val)
+		if !ok {
+			return o.unmarshalTextKind(data)
 		}
 
 		if err := enc.UnmarshalText(data); err != nil {
@@ -664,6 +764,118 @@ func (o *Option[T]) UnmarshalText(data []byte) error {
 	return nil
 }
 
+// unmarshalTextKind is the fallback of UnmarshalText for types
+// which do not implement [encoding.TextUnmarshaler].
+func (o *Option[T]) unmarshalTextKind(data []byte) error {
+	ok, err := unmarshalTextKind(&o.val, data)
+	if !ok || err != nil {
+		// only allocate in slow path.
+		// this overrides any previously defined value in the field.
+		o.val = types.ZeroValue[T]()
+
+		if !ok {
+			return fmt.Errorf("type %T does not implement encoding.TextUnmarshaler", o.val)
+		}
+
+		return fmt.Errorf("error unmarshaling data: %w", err)
+	}
+
+	o.some = true
+
+	return nil
+}
+
+// marshalTextKind returns the text representation of val if it is a
+// [time.Duration] or its underlying type is a scalar type.
+// ok is false if the type is not supported.
+func marshalTextKind(val any) (raw []byte, ok bool) {
+	if dur, isDur := val.(time.Duration); isDur {
+		return []byte(dur.String()), true
+	}
+
+	rv := reflect.ValueOf(val)
+
+	switch rv.Kind() {
+	case reflect.String:
+		return []byte(rv.String()), true
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, rv.Int(), 10), true
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, rv.Uint(), 10), true
+
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, rv.Float(), 'f', -1, rv.Type().Bits()), true
+
+	case reflect.Bool:
+		return strconv.AppendBool(nil, rv.Bool()), true
+	}
+
+	return nil, false
+}
+
+// unmarshalTextKind parses data into dest, which must be a pointer to
+// a [time.Duration] or to a type whose underlying type is a scalar type,
+// e.g. a named integer type.
+// ok is false if the type is not supported.
+func unmarshalTextKind(dest any, data []byte) (ok bool, err error) {
+	if dur, isDur := dest.(*time.Duration); isDur {
+		parsed, err := time.ParseDuration(bytes2String(data))
+		if err != nil {
+			return true, err
+		}
+
+		*dur = parsed
+
+		return true, nil
+	}
+
+	val := reflect.ValueOf(dest).Elem()
+
+	switch val.Kind() {
+	case reflect.String:
+		val.SetString(string(data))
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		num, err := strconv.ParseInt(bytes2String(data), 10, val.Type().Bits())
+		if err != nil {
+			return true, err
+		}
+
+		val.SetInt(num)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		num, err := strconv.ParseUint(bytes2String(data), 10, val.Type().Bits())
+		if err != nil {
+			return true, err
+		}
+
+		val.SetUint(num)
+
+	case reflect.Float32, reflect.Float64:
+		num, err := strconv.ParseFloat(bytes2String(data), val.Type().Bits())
+		if err != nil {
+			return true, err
+		}
+
+		val.SetFloat(num)
+
+	case reflect.Bool:
+		var b bool
+		if err := unmarshalText(&b, data); err != nil {
+			return true, err
+		}
+
+		val.SetBool(b)
+
+	default:
+		return false, nil
+	}
+
+	return true, nil
+}
+
 func unmarshalText(dest interface{}, data []byte) error {
 	switch val := any(dest).(type) {
 	case *string:
@@ -713,6 +925,14 @@ func unmarshalText(dest interface{}, data []byte) error {
 
 		*val = int64(num)
 
+	case *uint:
+		num, err := strconv.ParseUint(bytes2String(data), 10, strconv.IntSize)
+		if err != nil {
+			return err
+		}
+
+		*val = uint(num)
+
 	case *uint8:
 		num, err := strconv.ParseUint(bytes2String(data), 10, 8)
 		if err != nil {
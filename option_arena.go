@@ -0,0 +1,28 @@
+//go:build go1.20 && goexperiment.arenas
+// +build go1.20,goexperiment.arenas
+
+package typact
+
+import (
+	"arena"
+
+	"go.l0nax.org/typact/std/clone"
+)
+
+// CloneInto returns a deep copy of T allocated in a, if o contains a value.
+// Otherwise [None] is returned.
+//
+// The value is cloned using [clone.WithArena], see its documentation
+// for the values which are still allocated on the heap.
+// Use [go.l0nax.org/typact/std/option.MoveToHeap] to move the value out of the arena again.
+//
+// WARN: The returned value must not be used after a has been freed!
+//
+// Unstable: This method is unstable and not guarded by the SemVer promise!
+func (o Option[T]) CloneInto(a *arena.Arena) Option[T] {
+	if o.IsNone() {
+		return None[T]()
+	}
+
+	return Some(clone.DeepWithOptions(o.val, clone.WithArena(a)))
+}
@@ -171,3 +171,17 @@ func ExampleOption_CloneWith_custom() {
 	// Output:
 	// [Hello World]
 }
+
+func ExampleOption_Iter() {
+	for val := range typact.Some("foo").Iter() {
+		fmt.Println(val)
+	}
+
+	// Does print nothing
+	for val := range typact.None[string]().Iter() {
+		fmt.Println(val)
+	}
+
+	// Output:
+	// foo
+}
@@ -0,0 +1,166 @@
+package pgxtypact
+
+import (
+	"reflect"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// firstNormalOID is the first OID assigned to user-defined objects.
+// All OIDs below are reserved for the builtin types of PostgreSQL.
+const firstNormalOID = 16384
+
+// Register adds [typact.Option] support to all builtin types of m.
+//
+// Types registered in m after calling Register, e.g. composite or enum types
+// loaded with [pgx.Conn.LoadType], must be registered using [RegisterType].
+func Register(m *pgtype.Map) {
+	for oid := uint32(1); oid < firstNormalOID; oid++ {
+		if t, ok := m.TypeForOID(oid); ok {
+			RegisterType(m, t)
+		}
+	}
+}
+
+// RegisterType registers t in m with [typact.Option] support.
+// It is safe to call RegisterType multiple times for the same type.
+func RegisterType(m *pgtype.Map, t *pgtype.Type) {
+	if _, ok := t.Codec.(*Codec); ok {
+		m.RegisterType(t)
+		return
+	}
+
+	m.RegisterType(&pgtype.Type{
+		Name:  t.Name,
+		OID:   t.OID,
+		Codec: &Codec{Codec: t.Codec},
+	})
+}
+
+// Codec wraps a [pgtype.Codec] to encode and scan [typact.Option] values
+// using the wrapped codec.
+//
+// [typact.None] is encoded as NULL and NULL is scanned as [typact.None].
+type Codec struct {
+	pgtype.Codec
+}
+
+// PlanEncode implements the [pgtype.Codec] interface.
+func (c *Codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	info, ok := optionInfoOf(reflect.TypeOf(value))
+	if !ok {
+		return c.Codec.PlanEncode(m, oid, format, value)
+	}
+
+	next := m.PlanEncode(oid, format, reflect.Zero(info.elem).Interface())
+	if next == nil {
+		return nil
+	}
+
+	return &encodePlanOption{
+		info: info,
+		next: next,
+	}
+}
+
+// PlanScan implements the [pgtype.Codec] interface.
+func (c *Codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	typ := reflect.TypeOf(target)
+	if typ == nil || typ.Kind() != reflect.Pointer {
+		return c.Codec.PlanScan(m, oid, format, target)
+	}
+
+	info, ok := optionInfoOf(typ.Elem())
+	if !ok {
+		return c.Codec.PlanScan(m, oid, format, target)
+	}
+
+	next := m.PlanScan(oid, format, reflect.New(info.elem).Interface())
+
+	return &scanPlanOption{
+		info: info,
+		next: next,
+	}
+}
+
+// optionInfo holds the reflection information of a [typact.Option] type.
+type optionInfo struct {
+	// elem is the type of the value.
+	elem reflect.Type
+	// unwrap is the index of the UnsafeUnwrap method.
+	unwrap int
+	// insert is the index of the Insert method of the pointer type.
+	insert int
+}
+
+// optionInfoOf returns the [optionInfo] of typ if it is a [typact.Option],
+// i.e. it implements [optionLike] and provides the UnsafeUnwrap and Insert methods.
+func optionInfoOf(typ reflect.Type) (optionInfo, bool) {
+	if typ == nil || !typ.Implements(optionLikeImpl) {
+		return optionInfo{}, false
+	}
+
+	unwrap, ok := typ.MethodByName("UnsafeUnwrap")
+	if !ok {
+		return optionInfo{}, false
+	}
+
+	insert, ok := reflect.PointerTo(typ).MethodByName("Insert")
+	if !ok {
+		return optionInfo{}, false
+	}
+
+	return optionInfo{
+		elem:   unwrap.Type.Out(0),
+		unwrap: unwrap.Index,
+		insert: insert.Index,
+	}, true
+}
+
+// optionLike is implemented by [typact.Option].
+type optionLike interface {
+	IsSome() bool
+}
+
+// optionLikeImpl holds the [reflect.Type] of [optionLike].
+var optionLikeImpl = reflect.TypeOf((*optionLike)(nil)).Elem()
+
+type encodePlanOption struct {
+	info optionInfo
+	next pgtype.EncodePlan
+}
+
+func (plan *encodePlanOption) Encode(value any, buf []byte) ([]byte, error) {
+	if !value.(optionLike).IsSome() {
+		// nil signals NULL
+		return nil, nil
+	}
+
+	val := reflect.ValueOf(value).Method(plan.info.unwrap).Call(nil)[0]
+
+	return plan.next.Encode(val.Interface(), buf)
+}
+
+type scanPlanOption struct {
+	info optionInfo
+	next pgtype.ScanPlan
+}
+
+func (plan *scanPlanOption) Scan(src []byte, target any) error {
+	opt := reflect.ValueOf(target)
+
+	if src == nil {
+		opt.Elem().SetZero()
+		return nil
+	}
+
+	// NOTE: Insert returns a pointer to the value held by the Option.
+	ptr := opt.Method(plan.info.insert).Call([]reflect.Value{reflect.Zero(plan.info.elem)})[0]
+
+	if err := plan.next.Scan(src, ptr.Interface()); err != nil {
+		opt.Elem().SetZero()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package pgxtypact_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/pgxtypact"
+)
+
+func newMap() *pgtype.Map {
+	m := pgtype.NewMap()
+	pgxtypact.Register(m)
+
+	return m
+}
+
+var formats = map[string]int16{
+	"text":   pgtype.TextFormatCode,
+	"binary": pgtype.BinaryFormatCode,
+}
+
+// roundTrip encodes src and scans the result into dst.
+func roundTrip(t *testing.T, m *pgtype.Map, oid uint32, format int16, src, dst any) {
+	t.Helper()
+
+	buf, err := m.Encode(oid, format, src, nil)
+	if err != nil {
+		t.Fatalf("failed to encode %#v: %v", src, err)
+	}
+
+	if err := m.Scan(oid, format, buf, dst); err != nil {
+		t.Fatalf("failed to scan %#v: %v", src, err)
+	}
+}
+
+func TestOption_roundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		oid  uint32
+		src  any
+		dst  any
+	}{
+		{"int8", pgtype.Int8OID, typact.Some[int64](42), new(typact.Option[int64])},
+		{"int4", pgtype.Int4OID, typact.Some[int32](-5), new(typact.Option[int32])},
+		{"int8 to int", pgtype.Int8OID, typact.Some(7), new(typact.Option[int])},
+		{"float8", pgtype.Float8OID, typact.Some(1.5), new(typact.Option[float64])},
+		{"bool", pgtype.BoolOID, typact.Some(true), new(typact.Option[bool])},
+		{"text", pgtype.TextOID, typact.Some("foo"), new(typact.Option[string])},
+		{"bytea", pgtype.ByteaOID, typact.Some([]byte("bar")), new(typact.Option[[]byte])},
+		{"nested", pgtype.Int8OID, typact.Some(typact.Some[int64](1)), new(typact.Option[typact.Option[int64]])},
+		{"array", pgtype.Int8ArrayOID, typact.Some([]int64{1, 2}), new(typact.Option[[]int64])},
+		{"None", pgtype.Int8OID, typact.None[int64](), new(typact.Option[int64])},
+		{"None text", pgtype.TextOID, typact.None[string](), new(typact.Option[string])},
+	}
+
+	for fmtName, format := range formats {
+		for _, tt := range tests {
+			t.Run(fmtName+"/"+tt.name, func(t *testing.T) {
+				roundTrip(t, newMap(), tt.oid, format, tt.src, tt.dst)
+
+				got := reflect.ValueOf(tt.dst).Elem().Interface()
+				if !reflect.DeepEqual(got, tt.src) {
+					t.Errorf("expected %v, got %v", tt.src, got)
+				}
+			})
+		}
+	}
+}
+
+func TestOption_scanOverridesValue(t *testing.T) {
+	m := newMap()
+	dst := typact.Some[int64](5)
+
+	if err := m.Scan(pgtype.Int8OID, pgtype.BinaryFormatCode, nil, &dst); err != nil {
+		t.Fatal(err)
+	}
+
+	if dst.IsSome() {
+		t.Errorf("expected None, got %v", dst)
+	}
+
+	// invalid binary data must reset the Option
+	dst = typact.Some[int64](5)
+	if err := m.Scan(pgtype.Int8OID, pgtype.BinaryFormatCode, []byte{1}, &dst); err == nil {
+		t.Error("expected error on invalid data")
+	}
+
+	if dst.IsSome() {
+		t.Errorf("expected None after error, got %v", dst)
+	}
+}
+
+func TestOption_binaryFormat(t *testing.T) {
+	m := newMap()
+
+	buf, err := m.Encode(pgtype.Int8OID, pgtype.BinaryFormatCode, typact.Some[int64](1), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// int8 is encoded as 8 byte big-endian, the text format would be "1".
+	if expected := []byte{0, 0, 0, 0, 0, 0, 0, 1}; !reflect.DeepEqual(buf, expected) {
+		t.Errorf("expected binary encoding %v, got %v", expected, buf)
+	}
+}
+
+func TestOption_arrayElements(t *testing.T) {
+	src := []typact.Option[int]{typact.Some(1), typact.None[int](), typact.Some(3)}
+
+	for fmtName, format := range formats {
+		t.Run(fmtName, func(t *testing.T) {
+			m := newMap()
+
+			var dst []typact.Option[int]
+			roundTrip(t, m, pgtype.Int8ArrayOID, format, src, &dst)
+
+			if !reflect.DeepEqual(dst, src) {
+				t.Errorf("expected %v, got %v", src, dst)
+			}
+
+			// the NULL elements must be scanned into plain slices as well
+			var ptrs []*int
+			roundTrip(t, m, pgtype.Int8ArrayOID, format, src, &ptrs)
+
+			if len(ptrs) != 3 || ptrs[1] != nil || *ptrs[2] != 3 {
+				t.Errorf("unexpected result %v", ptrs)
+			}
+		})
+	}
+}
+
+type point struct {
+	X typact.Option[int32]
+	Y typact.Option[string]
+}
+
+func TestOption_composite(t *testing.T) {
+	m := newMap()
+
+	int4, _ := m.TypeForOID(pgtype.Int4OID)
+	text, _ := m.TypeForOID(pgtype.TextOID)
+
+	const pointOID = 100000
+	pgxtypact.RegisterType(m, &pgtype.Type{
+		Name: "point_opt",
+		OID:  pointOID,
+		Codec: &pgtype.CompositeCodec{
+			Fields: []pgtype.CompositeCodecField{
+				{Name: "x", Type: int4},
+				{Name: "y", Type: text},
+			},
+		},
+	})
+
+	for fmtName, format := range formats {
+		t.Run(fmtName, func(t *testing.T) {
+			// composite types are scanned field by field
+			src := pgtype.CompositeFields{typact.Some[int32](1), typact.None[string]()}
+
+			buf, err := m.Encode(pointOID, format, src, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var dst point
+			if err := m.Scan(pointOID, format, buf, pgtype.CompositeFields{&dst.X, &dst.Y}); err != nil {
+				t.Fatal(err)
+			}
+
+			expected := point{X: typact.Some[int32](1)}
+			if !reflect.DeepEqual(dst, expected) {
+				t.Errorf("expected %v, got %v", expected, dst)
+			}
+
+			// the whole composite type may be NULL
+			var opt typact.Option[point]
+			if err := m.Scan(pointOID, format, nil, &opt); err != nil {
+				t.Fatal(err)
+			}
+
+			if opt.IsSome() {
+				t.Errorf("expected None, got %v", opt)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+package pgxtypact_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/pgxtypact"
+)
+
+// fakeColumn describes a column returned by [fakeServer].
+type fakeColumn struct {
+	name string
+	oid  uint32
+}
+
+// fakeServer is a minimal PostgreSQL backend which answers every
+// query with the configured columns and rows.
+type fakeServer struct {
+	paramOIDs []uint32
+	columns   []fakeColumn
+	rows      [][]any
+
+	// params holds the parameters of the last Bind message.
+	params *pgproto3.Bind
+}
+
+// connect returns a new [pgx.Conn] connected to s, with [pgxtypact.Register] applied.
+func (s *fakeServer) connect(t *testing.T) *pgx.Conn {
+	t.Helper()
+
+	cfg, err := pgx.ParseConfig("postgres://user@localhost:5432/db?sslmode=disable")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg.LookupFunc = func(context.Context, string) ([]string, error) {
+		return []string{"127.0.0.1"}, nil
+	}
+
+	cfg.DialFunc = func(context.Context, string, string) (net.Conn, error) {
+		client, server := net.Pipe()
+
+		go func() {
+			defer server.Close()
+
+			if err := s.serve(server); err != nil {
+				t.Errorf("fake server: %v", err)
+			}
+		}()
+
+		return client, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := pgx.ConnectConfig(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = conn.Close(context.Background())
+	})
+
+	pgxtypact.Register(conn.TypeMap())
+
+	return conn
+}
+
+func (s *fakeServer) serve(conn net.Conn) error {
+	backend := pgproto3.NewBackend(conn, conn)
+
+	if _, err := backend.ReceiveStartupMessage(); err != nil {
+		return err
+	}
+
+	backend.Send(&pgproto3.AuthenticationOk{})
+	backend.Send(&pgproto3.BackendKeyData{ProcessID: 1, SecretKey: 1})
+	backend.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+
+	if err := backend.Flush(); err != nil {
+		return err
+	}
+
+	for {
+		msg, err := backend.Receive()
+		if err != nil {
+			return err
+		}
+
+		switch msg := msg.(type) {
+		case *pgproto3.Parse:
+			backend.Send(&pgproto3.ParseComplete{})
+
+		case *pgproto3.Describe:
+			if msg.ObjectType == 'S' {
+				backend.Send(&pgproto3.ParameterDescription{ParameterOIDs: s.paramOIDs})
+			}
+
+			s.sendRowDescription(backend)
+
+		case *pgproto3.Bind:
+			// NOTE: The message is reused by the backend.
+			s.params = &pgproto3.Bind{
+				ParameterFormatCodes: append([]int16(nil), msg.ParameterFormatCodes...),
+				ResultFormatCodes:    append([]int16(nil), msg.ResultFormatCodes...),
+			}
+			for _, p := range msg.Parameters {
+				s.params.Parameters = append(s.params.Parameters, append([]byte(nil), p...))
+			}
+
+			backend.Send(&pgproto3.BindComplete{})
+
+		case *pgproto3.Execute:
+			if err := s.sendRows(backend); err != nil {
+				return err
+			}
+
+		case *pgproto3.Sync:
+			backend.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+
+			if err := backend.Flush(); err != nil {
+				return err
+			}
+
+		case *pgproto3.Terminate:
+			return nil
+
+		default:
+			return fmt.Errorf("unexpected message %T", msg)
+		}
+	}
+}
+
+func (s *fakeServer) sendRowDescription(backend *pgproto3.Backend) {
+	if len(s.columns) == 0 {
+		backend.Send(&pgproto3.NoData{})
+		return
+	}
+
+	fields := make([]pgproto3.FieldDescription, 0, len(s.columns))
+	for i, col := range s.columns {
+		fields = append(fields, pgproto3.FieldDescription{
+			Name:        []byte(col.name),
+			DataTypeOID: col.oid,
+			Format:      s.resultFormat(i),
+		})
+	}
+
+	backend.Send(&pgproto3.RowDescription{Fields: fields})
+}
+
+func (s *fakeServer) sendRows(backend *pgproto3.Backend) error {
+	m := pgtype.NewMap()
+
+	for _, row := range s.rows {
+		values := make([][]byte, 0, len(row))
+
+		for i, val := range row {
+			buf, err := m.Encode(s.columns[i].oid, s.resultFormat(i), val, nil)
+			if err != nil {
+				return err
+			}
+
+			values = append(values, buf)
+		}
+
+		backend.Send(&pgproto3.DataRow{Values: values})
+	}
+
+	backend.Send(&pgproto3.CommandComplete{CommandTag: []byte(fmt.Sprintf("SELECT %d", len(s.rows)))})
+
+	return nil
+}
+
+// resultFormat returns the format code of the i-th column requested by the client.
+func (s *fakeServer) resultFormat(i int) int16 {
+	if s.params == nil {
+		return pgtype.TextFormatCode
+	}
+
+	switch codes := s.params.ResultFormatCodes; len(codes) {
+	case 0:
+		return pgtype.TextFormatCode
+	case 1:
+		return codes[0]
+	default:
+		return codes[i]
+	}
+}
+
+func TestConn_scan(t *testing.T) {
+	srv := &fakeServer{
+		columns: []fakeColumn{
+			{"id", pgtype.Int8OID},
+			{"name", pgtype.TextOID},
+			{"scores", pgtype.Int4ArrayOID},
+		},
+		rows: [][]any{
+			{int64(1), "foo", []any{int32(1), nil, int32(3)}},
+			{int64(2), nil, nil},
+		},
+	}
+
+	conn := srv.connect(t)
+
+	rows, err := conn.Query(context.Background(), "SELECT id, name, scores FROM users")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type user struct {
+		ID     typact.Option[int64]
+		Name   typact.Option[string]
+		Scores typact.Option[[]typact.Option[int32]]
+	}
+
+	users, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (user, error) {
+		var u user
+		err := row.Scan(&u.ID, &u.Name, &u.Scores)
+
+		return u, err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []user{
+		{
+			ID:     typact.Some[int64](1),
+			Name:   typact.Some("foo"),
+			Scores: typact.Some([]typact.Option[int32]{typact.Some[int32](1), typact.None[int32](), typact.Some[int32](3)}),
+		},
+		{
+			ID: typact.Some[int64](2),
+		},
+	}
+
+	if !reflect.DeepEqual(users, expected) {
+		t.Errorf("expected %v, got %v", expected, users)
+	}
+
+	// the binary format must be used for the non-text columns
+	for _, i := range []int{0, 2} {
+		if srv.resultFormat(i) != pgtype.BinaryFormatCode {
+			t.Errorf("expected binary format for column %d", i)
+		}
+	}
+}
+
+func TestConn_exec(t *testing.T) {
+	srv := &fakeServer{
+		paramOIDs: []uint32{pgtype.Int8OID, pgtype.TextOID, pgtype.Int8ArrayOID},
+	}
+
+	conn := srv.connect(t)
+
+	_, err := conn.Exec(context.Background(), "INSERT INTO users VALUES ($1, $2, $3)",
+		typact.Some[int64](1),
+		typact.None[string](),
+		[]typact.Option[int64]{typact.Some[int64](2), typact.None[int64]()},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := srv.params
+	if len(params.Parameters) != 3 {
+		t.Fatalf("expected 3 parameters, got %d", len(params.Parameters))
+	}
+
+	m := pgtype.NewMap()
+
+	var id int64
+	if err := m.Scan(pgtype.Int8OID, params.ParameterFormatCodes[0], params.Parameters[0], &id); err != nil || id != 1 {
+		t.Errorf("expected id 1, got %d (%v)", id, err)
+	}
+
+	if params.ParameterFormatCodes[0] != pgtype.BinaryFormatCode {
+		t.Error("expected binary format for int8 parameter")
+	}
+
+	if params.Parameters[1] != nil {
+		t.Errorf("expected NULL for None, got %q", params.Parameters[1])
+	}
+
+	var arr []*int64
+	if err := m.Scan(pgtype.Int8ArrayOID, params.ParameterFormatCodes[2], params.Parameters[2], &arr); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(arr) != 2 || *arr[0] != 2 || arr[1] != nil {
+		t.Errorf("unexpected array %v", arr)
+	}
+}
@@ -0,0 +1,16 @@
+// Package pgxtypact integrates [typact.Option] with pgx (https://github.com/jackc/pgx).
+//
+// By default pgx handles [typact.Option] through its [sql.Scanner] and
+// [driver.Valuer] implementations, which forces a round trip through the
+// text format. After calling [Register] on a [pgtype.Map], Option values are
+// encoded and decoded using the codec of the underlying PostgreSQL type, i.e.
+// the binary protocol is used and Options can be used as array elements
+// (e.g. []Option[int] for int8[] with NULLs) and composite type fields:
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		pgxtypact.Register(conn.TypeMap())
+//		return nil
+//	}
+//
+// This package lives in its own module so the core of typact stays free of dependencies.
+package pgxtypact
@@ -0,0 +1,17 @@
+module go.l0nax.org/typact/pgxtypact
+
+go 1.23.1
+
+replace go.l0nax.org/typact => ../
+
+require (
+	github.com/jackc/pgx/v5 v5.7.6
+	go.l0nax.org/typact v0.0.0-20240124124719-7814e9856468
+)
+
+require (
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
+	golang.org/x/crypto v0.37.0 // indirect
+	golang.org/x/text v0.24.0 // indirect
+)
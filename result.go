@@ -0,0 +1,420 @@
+package typact
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.l0nax.org/typact/internal/types"
+	"go.l0nax.org/typact/std/xhash"
+)
+
+// Result represents either a success value ([Ok]) or a failure ([Err]).
+// It is the typed counterpart to the idiomatic (T, error) return pair.
+//
+// The zero value of [Result] is [Ok] with the zero value of T.
+//
+// It is based on the std::result::Result type from
+// Rust (https://doc.rust-lang.org/std/result/enum.Result.html).
+type Result[T any] struct {
+	val T
+	err error
+}
+
+// Ok returns [Result] with val.
+//
+//gcassert:inline
+func Ok[T any](val T) Result[T] {
+	return Result[T]{
+		val: val,
+	}
+}
+
+// Err returns [Result] with err.
+//
+// WARN: This function panics if err is nil!
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		panic("typact: Err called with nil error")
+	}
+
+	return Result[T]{
+		err: err,
+	}
+}
+
+// WrapResult wraps val and err into a [Result].
+// If err is not nil, val is discarded and [Err] is returned.
+//
+// To eagerly evaluate and wrap a value, use [TryWrapResult].
+func WrapResult[T any](val T, err error) Result[T] {
+	if err != nil {
+		return Result[T]{
+			err: err,
+		}
+	}
+
+	return Ok(val)
+}
+
+// TryWrapResult executes fn and wraps the return values in a [Result].
+func TryWrapResult[T any](fn func() (T, error)) Result[T] {
+	return WrapResult(fn())
+}
+
+// IsOk returns true if r contains a value.
+//
+//gcassert:inline
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsOkAnd returns true if r contains a value and fn returns true.
+func (r Result[T]) IsOkAnd(fn func(T) bool) bool {
+	return r.err == nil && fn(r.val)
+}
+
+// IsErr returns true if r contains an error.
+//
+//gcassert:inline
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// IsErrAnd returns true if r contains an error and fn returns true.
+func (r Result[T]) IsErrAnd(fn func(error) bool) bool {
+	return r.err != nil && fn(r.err)
+}
+
+// ErrIs reports whether r contains an error which matches target.
+// See [errors.Is] for more details.
+func (r Result[T]) ErrIs(target error) bool {
+	return r.err != nil && errors.Is(r.err, target)
+}
+
+// ErrAs finds the first error in the chain of the contained error which
+// matches target, and if one is found, sets target to that error value and returns true.
+// It returns false if r is [Ok].
+//
+// See [errors.As] for more details.
+func (r Result[T]) ErrAs(target any) bool {
+	return r.err != nil && errors.As(r.err, target)
+}
+
+// Deconstruct returns the value and the error of r.
+//
+//gcassert:inline
+func (r Result[T]) Deconstruct() (T, error) {
+	return r.val, r.err
+}
+
+// Ok converts r into an [Option].
+// It returns [Some] if r is [Ok], otherwise [None].
+func (r Result[T]) Ok() Option[T] {
+	if r.err == nil {
+		return Some(r.val)
+	}
+
+	return None[T]()
+}
+
+// Err returns the contained error as [Option].
+// It returns [Some] if r is [Err], otherwise [None].
+func (r Result[T]) Err() Option[error] {
+	if r.err != nil {
+		return Some(r.err)
+	}
+
+	return None[error]()
+}
+
+// UnsafeUnwrap returns the value without checking whether
+// r contains an error.
+//
+// WARN: Only use this method as a last resort!
+func (r Result[T]) UnsafeUnwrap() T {
+	return r.val
+}
+
+// Expect returns the contained value of r, if it is [Ok].
+// Otherwise it panics with msg and the contained error.
+func (r Result[T]) Expect(msg string) T {
+	if r.err == nil {
+		return r.val
+	}
+
+	panic(fmt.Errorf("%s: %w", msg, r.err))
+}
+
+// Unwrap returns the value or panics with the contained
+// error if r is [Err].
+func (r Result[T]) Unwrap() T {
+	if r.err == nil {
+		return r.val
+	}
+
+	panic(fmt.Errorf("result contains an error: %w", r.err))
+}
+
+// ExpectErr returns the contained error of r, if it is [Err].
+// Otherwise it panics with msg.
+func (r Result[T]) ExpectErr(msg string) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	panic(msg)
+}
+
+// UnwrapErr returns the contained error or panics
+// if r is [Ok].
+func (r Result[T]) UnwrapErr() error {
+	if r.err != nil {
+		return r.err
+	}
+
+	panic("result does not contain an error")
+}
+
+// UnwrapOr returns the value of r, if [Ok].
+// Otherwise the provided value is returned.
+func (r Result[T]) UnwrapOr(value T) T {
+	if r.err == nil {
+		return r.val
+	}
+
+	return value
+}
+
+// UnwrapOrZero returns the value of r, if [Ok].
+// Otherwise the zero value of T is returned.
+func (r Result[T]) UnwrapOrZero() T {
+	if r.err == nil {
+		return r.val
+	}
+
+	return types.ZeroValue[T]()
+}
+
+// UnwrapOrElse returns the value of r, if [Ok].
+// Otherwise it calls fn with the contained error and returns the value.
+func (r Result[T]) UnwrapOrElse(fn func(error) T) T {
+	if r.err == nil {
+		return r.val
+	}
+
+	return fn(r.err)
+}
+
+// Map maps Result[T] to Result[T] by calling fn on the value held by r, if [Ok].
+// Otherwise the error is passed through untouched.
+func (r Result[T]) Map(fn func(T) T) Result[T] {
+	if r.err == nil {
+		return Ok(fn(r.val))
+	}
+
+	return r
+}
+
+// MapErr maps the error held by r by calling fn, if [Err].
+// Otherwise the value is passed through untouched.
+//
+// If fn returns nil, the result becomes [Ok] with the zero value of T.
+func (r Result[T]) MapErr(fn func(error) error) Result[T] {
+	if r.err == nil {
+		return r
+	}
+
+	return WrapResult(types.ZeroValue[T](), fn(r.err))
+}
+
+// MapOr returns the provided default value (if [Err]),
+// or applies fn to the contained value (if [Ok]).
+func (r Result[T]) MapOr(fn func(T) T, value T) T {
+	if r.err == nil {
+		return fn(r.val)
+	}
+
+	return value
+}
+
+// MapOrElse applies mapFn to the value held by r, if [Ok],
+// and returns the result. Otherwise errFn is called with the contained error.
+func (r Result[T]) MapOrElse(mapFn func(T) T, errFn func(error) T) T {
+	if r.err == nil {
+		return mapFn(r.val)
+	}
+
+	return errFn(r.err)
+}
+
+// And returns res if r is [Ok], otherwise the error of r is returned.
+func (r Result[T]) And(res Result[T]) Result[T] {
+	if r.err == nil {
+		return res
+	}
+
+	return r
+}
+
+// AndThen calls fn with the value of r, if [Ok], and returns its result.
+// Otherwise the error of r is returned.
+func (r Result[T]) AndThen(fn func(T) Result[T]) Result[T] {
+	if r.err == nil {
+		return fn(r.val)
+	}
+
+	return r
+}
+
+// Or returns r if it is [Ok], otherwise res is returned.
+func (r Result[T]) Or(res Result[T]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+
+	return res
+}
+
+// OrElse returns r if it is [Ok].
+// Otherwise fn is called with the contained error and its result is returned.
+func (r Result[T]) OrElse(fn func(error) Result[T]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+
+	return fn(r.err)
+}
+
+// Inspect executes fn if r is [Ok].
+// It returns r.
+func (r Result[T]) Inspect(fn func(T)) Result[T] {
+	if r.err == nil {
+		fn(r.val)
+	}
+
+	return r
+}
+
+// InspectErr executes fn if r is [Err].
+// It returns r.
+func (r Result[T]) InspectErr(fn func(error)) Result[T] {
+	if r.err != nil {
+		fn(r.err)
+	}
+
+	return r
+}
+
+// Value implements the [driver.Valuer] interface.
+// It returns the value of r, as [Option.Value] does, if [Ok].
+// Otherwise the contained error is returned, which aborts the query.
+func (r Result[T]) Value() (driver.Value, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return Some(r.val).Value()
+}
+
+// Scan implements the [sql.Scanner] interface.
+//
+// A NULL value cannot be represented by [Result], thus scanning
+// NULL results in an error. Use [Option] for nullable columns.
+// Any scan error is returned AND stored in r.
+func (r *Result[T]) Scan(src any) error {
+	if src == nil {
+		*r = Err[T](errors.New("cannot scan NULL into Result"))
+
+		return r.err
+	}
+
+	var opt Option[T]
+
+	if err := opt.Scan(src); err != nil {
+		*r = Err[T](err)
+
+		return err
+	}
+
+	*r = Ok(opt.val)
+
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// It encodes the value of r if it is [Ok], otherwise the contained error is returned.
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	if r.err != nil {
+		return nil, fmt.Errorf("cannot marshal error result: %w", r.err)
+	}
+
+	return json.Marshal(r.val)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// Any decoding error is returned AND stored in r.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	var val T
+
+	if err := json.Unmarshal(data, &val); err != nil {
+		*r = Err[T](err)
+
+		return err
+	}
+
+	*r = Ok(val)
+
+	return nil
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// It behaves like [Option.MarshalText] if r is [Ok], otherwise the contained error is returned.
+func (r Result[T]) MarshalText() ([]byte, error) {
+	if r.err != nil {
+		return nil, fmt.Errorf("cannot marshal error result: %w", r.err)
+	}
+
+	return Some(r.val).MarshalText()
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// Any decoding error is returned AND stored in r.
+func (r *Result[T]) UnmarshalText(data []byte) error {
+	var opt Option[T]
+
+	if err := opt.UnmarshalText(data); err != nil {
+		*r = Err[T](err)
+
+		return err
+	}
+
+	*r = Ok(opt.val)
+
+	return nil
+}
+
+// Hash implements the [xhash.Hashable] interface.
+//
+// The error is hashed using its message.
+func (r Result[T]) Hash(h xhash.Hasher) {
+	if r.err != nil {
+		h.WriteUint64(1) // 1 = Err
+		h.WriteInterface(r.err.Error())
+
+		return
+	}
+
+	h.WriteUint64(2) // 2 = Ok
+	h.WriteInterface(any(r.val))
+}
+
+// String implements the [fmt.Stringer] interface.
+func (r Result[T]) String() string {
+	if r.err != nil {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+
+	return fmt.Sprintf("Ok(%v)", r.val)
+}
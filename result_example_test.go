@@ -0,0 +1,73 @@
+package typact_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strconv"
+
+	"go.l0nax.org/typact"
+)
+
+func ExampleWrapResult() {
+	res := typact.WrapResult(strconv.Atoi("42"))
+	fmt.Println(res)
+
+	res = typact.WrapResult(strconv.Atoi("foo"))
+	fmt.Println(res.IsErr())
+
+	// Output:
+	// Ok(42)
+	// true
+}
+
+func ExampleResult_AndThen() {
+	parse := func(s string) typact.Result[int] {
+		return typact.WrapResult(strconv.Atoi(s))
+	}
+	positive := func(n int) typact.Result[int] {
+		if n < 0 {
+			return typact.Err[int](errors.New("negative number"))
+		}
+
+		return typact.Ok(n)
+	}
+
+	fmt.Println(parse("5").AndThen(positive))
+	fmt.Println(parse("-5").AndThen(positive))
+
+	// Output:
+	// Ok(5)
+	// Err(negative number)
+}
+
+func ExampleResult_ErrIs() {
+	res := typact.Err[string](fmt.Errorf("unable to read config: %w", fs.ErrNotExist))
+
+	fmt.Println(res.ErrIs(fs.ErrNotExist))
+	fmt.Println(res.ErrIs(fs.ErrPermission))
+
+	// Output:
+	// true
+	// false
+}
+
+func ExampleResult_Ok() {
+	fmt.Println(typact.Ok("foo").Ok())
+	fmt.Println(typact.Err[string](errors.New("bar")).Ok())
+
+	// Output:
+	// Some(foo)
+	// None
+}
+
+func ExampleOption_OkOr() {
+	errMissing := errors.New("missing value")
+
+	fmt.Println(typact.Some(5).OkOr(errMissing))
+	fmt.Println(typact.None[int]().OkOr(errMissing))
+
+	// Output:
+	// Ok(5)
+	// Err(missing value)
+}
@@ -0,0 +1,48 @@
+package typact
+
+import (
+	"log/slog"
+	"sync/atomic"
+)
+
+// NoneLogPolicy controls how [None] is logged by [Option.LogValue].
+type NoneLogPolicy int32
+
+const (
+	// NoneLogNull logs [None] as a nil value, e.g. 'null' with [slog.JSONHandler].
+	// This is the default.
+	NoneLogNull NoneLogPolicy = iota
+	// NoneLogOmit logs [None] as an empty group, which is omitted
+	// entirely by handlers following the [slog.Handler] rules.
+	NoneLogOmit
+)
+
+// noneLogPolicy holds the [NoneLogPolicy] set with [SetNoneLogPolicy].
+var noneLogPolicy atomic.Int32
+
+// SetNoneLogPolicy sets how [None] is logged by [Option.LogValue].
+// It is safe for concurrent use, but it should be called during
+// the program initialization, e.g. in the main function.
+//
+// To drop [None] attributes regardless of the policy, wrap the
+// handler with [go.l0nax.org/typact/std/xslog.NewHandler].
+func SetNoneLogPolicy(policy NoneLogPolicy) {
+	noneLogPolicy.Store(int32(policy))
+}
+
+// LogValue implements the [slog.LogValuer] interface.
+//
+// If it is [Some], the value is logged as is. If T implements
+// [slog.LogValuer] itself, it is resolved by [slog].
+// [None] is logged according to the [NoneLogPolicy], see [SetNoneLogPolicy].
+func (o Option[T]) LogValue() slog.Value {
+	if o.some {
+		return slog.AnyValue(o.val)
+	}
+
+	if NoneLogPolicy(noneLogPolicy.Load()) == NoneLogOmit {
+		return slog.GroupValue()
+	}
+
+	return slog.AnyValue(nil)
+}
@@ -0,0 +1,275 @@
+package typact
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// sqlTimeLayouts holds the layouts used to parse a string into [time.Time].
+// They cover the formats used by the common SQL drivers.
+var sqlTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// convertAssign copies src, which must not be nil, to the value pointed to by dest.
+//
+// It mirrors the conversion rules of [database/sql] (see [sql.Rows.Scan]):
+//
+//   - all numeric types are converted into each other, overflows result in an error.
+//   - strings and byte slices are converted into each other and into numbers and bools.
+//   - [time.Time] is converted into strings and byte slices using [time.RFC3339Nano].
+//
+// Additionally, strings and byte slices are parsed into [time.Time]
+// and [driver.Valuer] values, e.g. [sql.NullString], are converted using their Value method.
+func convertAssign(dest, src any) error {
+	// common cases, without reflect.
+	switch s := src.(type) {
+	case string:
+		switch d := dest.(type) {
+		case *string:
+			*d = s
+			return nil
+		case *[]byte:
+			*d = []byte(s)
+			return nil
+		case *time.Time:
+			return parseSQLTime(d, s)
+		}
+
+	case []byte:
+		switch d := dest.(type) {
+		case *string:
+			*d = string(s)
+			return nil
+		case *[]byte:
+			*d = bytes.Clone(s)
+			return nil
+		case *any:
+			*d = bytes.Clone(s)
+			return nil
+		case *time.Time:
+			return parseSQLTime(d, string(s))
+		}
+
+	case time.Time:
+		switch d := dest.(type) {
+		case *time.Time:
+			*d = s
+			return nil
+		case *string:
+			*d = s.Format(time.RFC3339Nano)
+			return nil
+		case *[]byte:
+			*d = s.AppendFormat(make([]byte, 0, len(time.RFC3339Nano)), time.RFC3339Nano)
+			return nil
+		}
+	}
+
+	sv := reflect.ValueOf(src)
+
+	switch d := dest.(type) {
+	case *string:
+		switch sv.Kind() {
+		case reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			*d = asString(src)
+			return nil
+		}
+
+	case *[]byte:
+		if b, ok := asBytes(sv); ok {
+			*d = b
+			return nil
+		}
+
+	case *bool:
+		bv, err := driver.Bool.ConvertValue(src)
+		if err == nil {
+			*d = bv.(bool)
+		}
+
+		return err
+
+	case *any:
+		*d = src
+		return nil
+	}
+
+	dv := reflect.ValueOf(dest).Elem()
+	if sv.Type().AssignableTo(dv.Type()) {
+		if b, ok := src.([]byte); ok {
+			dv.Set(reflect.ValueOf(bytes.Clone(b)))
+		} else {
+			dv.Set(sv)
+		}
+
+		return nil
+	}
+
+	if dv.Kind() == sv.Kind() && sv.Type().ConvertibleTo(dv.Type()) {
+		dv.Set(sv.Convert(dv.Type()))
+		return nil
+	}
+
+	if vr, ok := src.(driver.Valuer); ok {
+		val, err := vr.Value()
+		if err != nil {
+			return err
+		}
+
+		if val == nil {
+			return fmt.Errorf("converting NULL of type %T is unsupported", src)
+		}
+
+		return convertAssign(dest, val)
+	}
+
+	// The following conversions use a string value as an intermediate representation
+	// to convert between various numeric types.
+	// This also allows scanning into user defined types such as "type Int int64".
+	switch dv.Kind() {
+	case reflect.Pointer:
+		dv.Set(reflect.New(dv.Type().Elem()))
+		return convertAssign(dv.Interface(), src)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s := asString(src)
+
+		i64, err := strconv.ParseInt(s, 10, dv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %w", src, s, dv.Kind(), strconvErr(err))
+		}
+
+		dv.SetInt(i64)
+
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		s := asString(src)
+
+		u64, err := strconv.ParseUint(s, 10, dv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %w", src, s, dv.Kind(), strconvErr(err))
+		}
+
+		dv.SetUint(u64)
+
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		s := asString(src)
+
+		f64, err := strconv.ParseFloat(s, dv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %w", src, s, dv.Kind(), strconvErr(err))
+		}
+
+		dv.SetFloat(f64)
+
+		return nil
+
+	case reflect.Bool:
+		bv, err := driver.Bool.ConvertValue(src)
+		if err != nil {
+			return err
+		}
+
+		dv.SetBool(bv.(bool))
+
+		return nil
+
+	case reflect.String:
+		switch v := src.(type) {
+		case string:
+			dv.SetString(v)
+			return nil
+		case []byte:
+			dv.SetString(string(v))
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported Scan, storing driver.Value type %T into type %T", src, dest)
+}
+
+// parseSQLTime parses s into dst using [sqlTimeLayouts].
+func parseSQLTime(dst *time.Time, s string) error {
+	for _, layout := range sqlTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			*dst = t
+			return nil
+		}
+	}
+
+	return fmt.Errorf("converting driver.Value type string (%q) to a time.Time: unsupported format", s)
+}
+
+// asString returns the string representation of src.
+func asString(src any) string {
+	switch v := src.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	}
+
+	rv := reflect.ValueOf(src)
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 64)
+	case reflect.Float32:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 32)
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	}
+
+	return fmt.Sprintf("%v", src)
+}
+
+// asBytes returns the textual representation of rv, if it is a
+// scalar value.
+func asBytes(rv reflect.Value) ([]byte, bool) {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, rv.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.AppendUint(nil, rv.Uint(), 10), true
+	case reflect.Float32:
+		return strconv.AppendFloat(nil, rv.Float(), 'g', -1, 32), true
+	case reflect.Float64:
+		return strconv.AppendFloat(nil, rv.Float(), 'g', -1, 64), true
+	case reflect.Bool:
+		return strconv.AppendBool(nil, rv.Bool()), true
+	case reflect.String:
+		return []byte(rv.String()), true
+	}
+
+	return nil, false
+}
+
+// strconvErr returns the underlying error of a [strconv.NumError].
+func strconvErr(err error) error {
+	var ne *strconv.NumError
+	if errors.As(err, &ne) {
+		return ne.Err
+	}
+
+	return err
+}
@@ -0,0 +1,41 @@
+package clone
+
+import (
+	"reflect"
+	"strings"
+)
+
+// allocator allocates the memory of the cloned values.
+// If no allocator is configured, the values are allocated on the heap.
+type allocator interface {
+	// New returns a pointer to a new zero value of typ.
+	New(typ reflect.Type) reflect.Value
+	// MakeSlice returns a new slice of typ with the given length and capacity.
+	MakeSlice(typ reflect.Type, length, capacity int) reflect.Value
+	// CloneString returns a copy of s.
+	CloneString(s string) string
+}
+
+func (c *cloner) newValue(typ reflect.Type) reflect.Value {
+	if c.cfg.alloc == nil {
+		return reflect.New(typ)
+	}
+
+	return c.cfg.alloc.New(typ)
+}
+
+func (c *cloner) makeSlice(typ reflect.Type, length, capacity int) reflect.Value {
+	if c.cfg.alloc == nil {
+		return reflect.MakeSlice(typ, length, capacity)
+	}
+
+	return c.cfg.alloc.MakeSlice(typ, length, capacity)
+}
+
+func (c *cloner) cloneString(s string) string {
+	if c.cfg.alloc == nil {
+		return strings.Clone(s)
+	}
+
+	return c.cfg.alloc.CloneString(s)
+}
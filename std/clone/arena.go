@@ -0,0 +1,55 @@
+//go:build go1.20 && goexperiment.arenas
+// +build go1.20,goexperiment.arenas
+
+package clone
+
+import (
+	"arena"
+	"reflect"
+	"unsafe"
+)
+
+// WithArena allocates the pointers, slices and strings of the
+// clone in a.
+//
+// Maps, interface values and the values returned by Clone methods
+// (see [std.Cloner]) are still allocated on the heap.
+//
+// WARN: The clone must not be used after a has been freed!
+func WithArena(a *arena.Arena) CloneOption {
+	return func(c *config) {
+		c.alloc = arenaAllocator{a: a}
+	}
+}
+
+// arenaAllocator implements [allocator] using an [arena.Arena].
+type arenaAllocator struct {
+	a *arena.Arena
+}
+
+func (aa arenaAllocator) New(typ reflect.Type) reflect.Value {
+	return reflect.ArenaNew(aa.a, typ)
+}
+
+func (aa arenaAllocator) MakeSlice(typ reflect.Type, length, capacity int) reflect.Value {
+	if capacity == 0 {
+		return reflect.MakeSlice(typ, 0, 0)
+	}
+
+	// NOTE: reflect does not provide a way to allocate a slice
+	// in an arena, thus we allocate the backing array instead.
+	arr := reflect.ArenaNew(aa.a, reflect.ArrayOf(capacity, typ.Elem()))
+
+	return arr.Elem().Slice3(0, length, capacity).Convert(typ)
+}
+
+func (aa arenaAllocator) CloneString(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	buf := arena.MakeSlice[byte](aa.a, len(s), len(s))
+	copy(buf, s)
+
+	return unsafe.String(unsafe.SliceData(buf), len(buf))
+}
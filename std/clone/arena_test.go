@@ -0,0 +1,51 @@
+//go:build go1.20 && goexperiment.arenas
+// +build go1.20,goexperiment.arenas
+
+package clone
+
+import (
+	"arena"
+	"reflect"
+	"testing"
+)
+
+func TestDeepWithOptions_arena(t *testing.T) {
+	type data struct {
+		Name  string
+		Tags  []string
+		Ptr   *int
+		Empty []int
+	}
+
+	num := 5
+	src := data{
+		Name:  "foo",
+		Tags:  []string{"bar", "baz"},
+		Ptr:   &num,
+		Empty: []int{},
+	}
+
+	a := arena.NewArena()
+
+	cpy := DeepWithOptions(src, WithArena(a))
+
+	if !reflect.DeepEqual(cpy, src) {
+		t.Fatalf("expected %+v, got %+v", src, cpy)
+	}
+
+	if cpy.Ptr == src.Ptr || &cpy.Tags[0] == &src.Tags[0] {
+		t.Error("clone shares memory with the source")
+	}
+
+	if cap(cpy.Tags) != cap(src.Tags) {
+		t.Errorf("expected capacity %d, got %d", cap(src.Tags), cap(cpy.Tags))
+	}
+
+	// moving the value out of the arena must work after freeing it
+	heap := Deep(cpy)
+	a.Free()
+
+	if !reflect.DeepEqual(heap, src) {
+		t.Errorf("expected %+v, got %+v", src, heap)
+	}
+}
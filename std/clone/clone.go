@@ -0,0 +1,402 @@
+package clone
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"go.l0nax.org/typact/internal/features"
+	"go.l0nax.org/typact/internal/types"
+)
+
+// ErrMaxDepth is returned if the nesting depth of a value exceeds the
+// limit configured with [WithMaxDepth].
+var ErrMaxDepth = errors.New("clone: maximum depth exceeded")
+
+// CloneOption configures the behavior of [DeepWithOptions] and [TryDeep].
+type CloneOption func(*config)
+
+type config struct {
+	unexported bool
+	maxDepth   int
+	alloc      allocator
+}
+
+// WithUnexported enables cloning of unexported struct fields.
+// By default unexported fields are copied by value, i.e. they are shared
+// between the source and the clone.
+func WithUnexported() CloneOption {
+	return func(c *config) {
+		c.unexported = true
+	}
+}
+
+// WithMaxDepth limits the nesting depth of the cloned value to depth.
+// Every pointer, interface, slice, array, map and struct which is
+// descended into counts as one level.
+//
+// If the limit is exceeded, cloning stops and [ErrMaxDepth] is returned,
+// instead of overflowing the stack on very deep (or degenerated) values.
+// A depth <= 0 disables the limit, which is the default.
+func WithMaxDepth(depth int) CloneOption {
+	return func(c *config) {
+		c.maxDepth = depth
+	}
+}
+
+// Deep returns a deep copy of v.
+//
+// The below values are handled specially:
+//
+//   - Types registered with [RegisterCloner]: the registered function is called.
+//   - Types implementing [std.Cloner]: the Clone method is called. Both, value
+//     and pointer receivers are supported.
+//   - Scalar types, strings, functions and unsafe.Pointer: copied by value.
+//   - Channels: shared, as there is no way to clone the buffered data.
+//   - Map keys: copied by value.
+//   - Unexported struct fields: copied by value, see [WithUnexported].
+//
+// The cloning of struct fields can be controlled with the "typact" struct tag:
+//
+//   - `typact:"shallow"`: the field is copied by value.
+//   - `typact:"skip"`: the field is set to its zero value in the clone,
+//     regardless of whether it is exported.
+//
+// Cyclic pointer graphs are supported: each pointer, map and slice is
+// cloned exactly once and all references point to the same clone.
+//
+// WARN: This function panics if the value cannot be cloned, e.g. because
+// of an invalid struct tag. Use [TryDeep] to get an error instead.
+func Deep[T any](v T) T {
+	return DeepWithOptions(v)
+}
+
+// DeepWithOptions works like [Deep] but allows to configure the behavior.
+//
+// WARN: This function panics if the value cannot be cloned, e.g. because
+// [WithMaxDepth] has been exceeded. Use [TryDeep] to get an error instead.
+func DeepWithOptions[T any](v T, opts ...CloneOption) T {
+	ret, err := TryDeep(v, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return ret
+}
+
+// TryDeep works like [DeepWithOptions] but returns an error
+// instead of panicking.
+func TryDeep[T any](v T, opts ...CloneOption) (T, error) {
+	var cfg config
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	// NOTE: We use a pointer to v to support interface types.
+	src := reflect.ValueOf(&v).Elem()
+	if isScalarCopyable(src.Kind()) && !getTypeInfo(src.Type()).cloner.IsValid() {
+		return v, nil
+	}
+
+	c := cloner{
+		cfg: cfg,
+	}
+
+	cloned, err := c.clone(src)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	// NOTE: We can not use a type assertion here since
+	// it would panic for nil interface values.
+	var ret T
+	reflect.ValueOf(&ret).Elem().Set(cloned)
+
+	return ret, nil
+}
+
+// visitKey identifies an already cloned pointer, map or slice.
+type visitKey struct {
+	ptr uintptr
+	len int
+	typ reflect.Type
+}
+
+type cloner struct {
+	cfg     config
+	visited map[visitKey]reflect.Value
+	depth   int
+}
+
+// clone returns a deep copy of src.
+func (c *cloner) clone(src reflect.Value) (reflect.Value, error) {
+	typ := src.Type()
+
+	info := getTypeInfo(typ)
+	if info.err != nil {
+		return reflect.Value{}, info.err
+	}
+
+	if info.cloner.IsValid() {
+		return c.callCloner(src, info), nil
+	}
+
+	if info.stateful {
+		return c.callStateCloner(src)
+	}
+
+	kind := src.Kind()
+	if isScalarCopyable(kind) {
+		return src, nil
+	}
+
+	switch kind {
+	case reflect.String:
+		// XXX: Special case: if [isScalarCopyable] returns false
+		// for a string, it means that we need to explicitly create a copy.
+		return reflect.ValueOf(c.cloneString(src.String())).Convert(typ), nil
+
+	case reflect.Chan:
+		return src, nil
+
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+		if src.IsNil() {
+			return reflect.Zero(typ), nil
+		}
+	}
+
+	if c.cfg.maxDepth > 0 && c.depth >= c.cfg.maxDepth {
+		return reflect.Value{}, fmt.Errorf("%w: limit of %d reached at type %s", ErrMaxDepth, c.cfg.maxDepth, typ)
+	}
+
+	c.depth++
+	ret, err := c.cloneComposite(src, info)
+	c.depth--
+
+	return ret, err
+}
+
+// cloneComposite returns a deep copy of src, which must be
+// a non-nil composite type.
+func (c *cloner) cloneComposite(src reflect.Value, info *typeInfo) (reflect.Value, error) {
+	switch src.Kind() {
+	case reflect.Pointer:
+		return c.clonePtr(src)
+
+	case reflect.Interface:
+		elem, err := c.clone(src.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		ret := reflect.New(src.Type()).Elem()
+		ret.Set(elem)
+
+		return ret, nil
+
+	case reflect.Slice:
+		return c.cloneSlice(src)
+
+	case reflect.Array:
+		return c.cloneArray(src)
+
+	case reflect.Map:
+		return c.cloneMap(src)
+
+	case reflect.Struct:
+		return c.cloneStruct(src, info)
+	}
+
+	panic("clone: type " + src.Type().String() + " not supported")
+}
+
+// callCloner calls the Clone method, or the registered function, of src.
+func (c *cloner) callCloner(src reflect.Value, info *typeInfo) reflect.Value {
+	if src.Kind() == reflect.Pointer && src.IsNil() {
+		return reflect.Zero(src.Type())
+	}
+
+	if !info.ptrRecv {
+		return info.cloner.Call([]reflect.Value{src})[0]
+	}
+
+	// NOTE: We copy the value into a new variable since src
+	// may not be addressable.
+	tmp := reflect.New(src.Type())
+	tmp.Elem().Set(src)
+
+	return info.cloner.Call([]reflect.Value{tmp})[0].Elem()
+}
+
+// callStateCloner clones src, which is handled by [types.StateCloneHook],
+// using the state of c.
+func (c *cloner) callStateCloner(src reflect.Value) (reflect.Value, error) {
+	return types.StateCloneHook.CloneUsing(src, c)
+}
+
+// CloneValue implements [types.DeepCloner].
+func (c *cloner) CloneValue(src reflect.Value) (reflect.Value, error) {
+	return c.clone(src)
+}
+
+func (c *cloner) clonePtr(src reflect.Value) (reflect.Value, error) {
+	key := visitKey{ptr: src.Pointer(), typ: src.Type()}
+	if cloned, ok := c.visited[key]; ok {
+		return cloned, nil
+	}
+
+	dst := c.newValue(src.Type().Elem())
+	c.visit(key, dst)
+
+	elem, err := c.clone(src.Elem())
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	dst.Elem().Set(elem)
+
+	return dst, nil
+}
+
+func (c *cloner) cloneSlice(src reflect.Value) (reflect.Value, error) {
+	key := visitKey{ptr: src.Pointer(), len: src.Len(), typ: src.Type()}
+	if cloned, ok := c.visited[key]; ok {
+		return cloned, nil
+	}
+
+	dst := c.makeSlice(src.Type(), src.Len(), src.Cap())
+	c.visit(key, dst)
+
+	if !getTypeInfo(src.Type().Elem()).needsDeepCopy {
+		// for scalar slices, we can copy the underlying values directly
+		// => fast path.
+		reflect.Copy(dst, src)
+
+		return dst, nil
+	}
+
+	return dst, c.cloneElems(dst, src)
+}
+
+func (c *cloner) cloneArray(src reflect.Value) (reflect.Value, error) {
+	dst := reflect.New(src.Type()).Elem()
+	if !getTypeInfo(src.Type().Elem()).needsDeepCopy {
+		dst.Set(src)
+
+		return dst, nil
+	}
+
+	return dst, c.cloneElems(dst, src)
+}
+
+// cloneElems clones all elements of the slice or array src into dst.
+func (c *cloner) cloneElems(dst, src reflect.Value) error {
+	for i := range src.Len() {
+		elem, err := c.clone(src.Index(i))
+		if err != nil {
+			return err
+		}
+
+		dst.Index(i).Set(elem)
+	}
+
+	return nil
+}
+
+func (c *cloner) cloneMap(src reflect.Value) (reflect.Value, error) {
+	key := visitKey{ptr: src.Pointer(), typ: src.Type()}
+	if cloned, ok := c.visited[key]; ok {
+		return cloned, nil
+	}
+
+	dst := reflect.MakeMapWithSize(src.Type(), src.Len())
+	c.visit(key, dst)
+
+	deep := getTypeInfo(src.Type().Elem()).needsDeepCopy
+
+	for iter := src.MapRange(); iter.Next(); {
+		val := iter.Value()
+
+		if deep {
+			var err error
+
+			val, err = c.clone(val)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+		}
+
+		dst.SetMapIndex(iter.Key(), val)
+	}
+
+	return dst, nil
+}
+
+func (c *cloner) cloneStruct(src reflect.Value, info *typeInfo) (reflect.Value, error) {
+	// NOTE: dst holds a shallow copy of src, thus we can read all
+	// fields from dst and replace them with the cloned value.
+	dst := reflect.New(src.Type()).Elem()
+	dst.Set(src)
+
+	for _, fld := range info.fields {
+		// NOTE: The policy is checked first, since skipped fields are
+		// always set to their zero value, even if unexported.
+		if !fld.exported && !c.cfg.unexported && fld.policy != policySkip {
+			continue
+		}
+
+		val := dst.Field(fld.index)
+		if !fld.exported {
+			// make the field accessible
+			val = reflect.NewAt(val.Type(), unsafe.Pointer(val.UnsafeAddr())).Elem()
+		}
+
+		if fld.policy == policySkip {
+			val.SetZero()
+			continue
+		}
+
+		cloned, err := c.clone(val)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		val.Set(cloned)
+	}
+
+	return dst, nil
+}
+
+// visit marks key as cloned to dst.
+func (c *cloner) visit(key visitKey, dst reflect.Value) {
+	if c.visited == nil {
+		c.visited = make(map[visitKey]reflect.Value)
+	}
+
+	c.visited[key] = dst
+}
+
+// isScalarCopyable returns whether the given Kind is a scalar type
+// which can be copied by value.
+func isScalarCopyable(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Bool,
+		reflect.Uintptr,
+		reflect.Func,
+		reflect.UnsafePointer,
+		reflect.Invalid,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64,
+		reflect.Complex64, reflect.Complex128:
+
+		return true
+	case reflect.String:
+		// WARN: With arenas, a string cannot be simply copied.
+		return !features.GoArenaAvail
+	}
+
+	return false
+}
@@ -0,0 +1,31 @@
+package clone_test
+
+import (
+	"fmt"
+
+	"go.l0nax.org/typact/std/clone"
+)
+
+func ExampleDeep() {
+	type Config struct {
+		Name   string
+		Tags   []string
+		Labels map[string]string
+	}
+
+	src := Config{
+		Name:   "app",
+		Tags:   []string{"foo"},
+		Labels: map[string]string{"env": "dev"},
+	}
+
+	cpy := clone.Deep(src)
+
+	src.Tags[0] = "changed"
+	src.Labels["env"] = "changed"
+
+	fmt.Println(cpy.Tags, cpy.Labels)
+
+	// Output:
+	// [foo] map[env:dev]
+}
@@ -0,0 +1,371 @@
+package clone
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.l0nax.org/typact/internal/types"
+)
+
+type node struct {
+	Value    int
+	Next     *node
+	Children []*node
+}
+
+type counted struct {
+	Data []int
+}
+
+// Clone implements [std.Cloner] with a pointer receiver.
+func (c *counted) Clone() *counted {
+	return &counted{
+		Data: append([]int{-1}, c.Data...),
+	}
+}
+
+type withUnexported struct {
+	Public  []int
+	private []int
+}
+
+type holder struct {
+	Any    any
+	Arr    [2][]string
+	Map    map[string][]int
+	Ch     chan int
+	Fn     func() int
+	Nested []counted
+	Ptr    *counted
+}
+
+func TestDeep_scalar(t *testing.T) {
+	if got := Deep(42); got != 42 {
+		t.Errorf("expected 42, got %d", got)
+	}
+
+	if got := Deep("foo"); got != "foo" {
+		t.Errorf("expected foo, got %s", got)
+	}
+}
+
+func TestDeep_nil(t *testing.T) {
+	var (
+		ptr *node
+		sl  []int
+		mm  map[string]int
+		ifc any
+	)
+
+	if Deep(ptr) != nil || Deep(sl) != nil || Deep(mm) != nil || Deep(ifc) != nil {
+		t.Error("expected nil values to stay nil")
+	}
+}
+
+func TestDeep_composite(t *testing.T) {
+	ch := make(chan int)
+	src := holder{
+		Any:    []int{1, 2},
+		Arr:    [2][]string{{"a"}, {"b"}},
+		Map:    map[string][]int{"a": {1}},
+		Ch:     ch,
+		Fn:     func() int { return 1 },
+		Nested: []counted{{Data: []int{1}}},
+		Ptr:    &counted{Data: []int{2}},
+	}
+
+	cpy := Deep(src)
+
+	src.Any.([]int)[0] = 100
+	src.Arr[0][0] = "changed"
+	src.Map["a"][0] = 100
+
+	if cpy.Any.([]int)[0] != 1 || cpy.Arr[0][0] != "a" || cpy.Map["a"][0] != 1 {
+		t.Errorf("clone shares memory with the source: %+v", cpy)
+	}
+
+	if cpy.Ch != ch {
+		t.Error("expected channel to be shared")
+	}
+
+	if cpy.Fn() != 1 {
+		t.Error("expected func to be copied")
+	}
+
+	// std.Cloner must be honoured at every depth
+	if !reflect.DeepEqual(cpy.Nested[0].Data, []int{-1, 1}) {
+		t.Errorf("expected Clone method to be called, got %v", cpy.Nested[0].Data)
+	}
+
+	if !reflect.DeepEqual(cpy.Ptr.Data, []int{-1, 2}) {
+		t.Errorf("expected Clone method to be called, got %v", cpy.Ptr.Data)
+	}
+}
+
+func TestDeep_cycle(t *testing.T) {
+	root := &node{Value: 1}
+	child := &node{Value: 2, Next: root}
+	root.Next = child
+	root.Children = []*node{child, child}
+
+	cpy := Deep(root)
+
+	if cpy == root || cpy.Next == child {
+		t.Fatal("expected new pointers")
+	}
+
+	if cpy.Next.Next != cpy {
+		t.Error("expected cycle to be preserved")
+	}
+
+	if cpy.Children[0] != cpy.Next || cpy.Children[1] != cpy.Next {
+		t.Error("expected shared pointers to be cloned exactly once")
+	}
+}
+
+func TestDeep_unexported(t *testing.T) {
+	src := withUnexported{
+		Public:  []int{1},
+		private: []int{2},
+	}
+
+	shallow := Deep(src)
+	deep := DeepWithOptions(src, WithUnexported())
+
+	src.Public[0] = 100
+	src.private[0] = 100
+
+	if shallow.Public[0] != 1 {
+		t.Error("expected exported field to be cloned")
+	}
+
+	if shallow.private[0] != 100 {
+		t.Error("expected unexported field to be shared by default")
+	}
+
+	if deep.private[0] != 2 {
+		t.Error("expected unexported field to be cloned with WithUnexported")
+	}
+}
+
+type tagged struct {
+	Shared  []int `typact:"shallow"`
+	Skipped []int `typact:"skip"`
+	Deep    []int
+	skipped *int `typact:"skip"`
+}
+
+type invalidTag struct {
+	Data []int `typact:"deep"`
+}
+
+func TestDeep_tags(t *testing.T) {
+	num := 5
+	src := tagged{
+		Shared:  []int{1},
+		Skipped: []int{2},
+		Deep:    []int{3},
+		skipped: &num,
+	}
+
+	cpy := Deep(src)
+	cpyUnexported := DeepWithOptions(src, WithUnexported())
+
+	src.Shared[0] = 100
+	src.Deep[0] = 100
+
+	if cpy.Shared[0] != 100 {
+		t.Error("expected shallow field to be shared")
+	}
+
+	if cpy.Skipped != nil {
+		t.Error("expected skipped field to be zero")
+	}
+
+	if cpy.Deep[0] != 3 {
+		t.Error("expected untagged field to be cloned")
+	}
+
+	if cpy.skipped != nil {
+		t.Error("expected unexported skipped field to be zero by default")
+	}
+
+	if cpyUnexported.skipped != nil {
+		t.Error("expected unexported skipped field to be zero with WithUnexported")
+	}
+}
+
+type hiddenSkip struct {
+	ID     int
+	secret string `typact:"skip"`
+}
+
+func TestDeep_skipUnexported(t *testing.T) {
+	cpy := Deep(hiddenSkip{ID: 1, secret: "token"})
+
+	if cpy.ID != 1 {
+		t.Error("expected exported field to be copied")
+	}
+
+	if cpy.secret != "" {
+		t.Error("expected unexported skipped field to be zero without WithUnexported")
+	}
+}
+
+func TestTryDeep_invalidTag(t *testing.T) {
+	_, err := TryDeep(invalidTag{})
+	if err == nil {
+		t.Fatal("expected error for invalid tag")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Deep to panic")
+		}
+	}()
+
+	Deep([]invalidTag{{}})
+}
+
+func TestTryDeep_maxDepth(t *testing.T) {
+	var head *node
+	for i := range 100 {
+		head = &node{Value: i, Next: head}
+	}
+
+	// every node counts as two levels: the pointer and the struct
+	_, err := TryDeep(head, WithMaxDepth(150))
+	if !errors.Is(err, ErrMaxDepth) {
+		t.Errorf("expected ErrMaxDepth, got %v", err)
+	}
+
+	cpy, err := TryDeep(head, WithMaxDepth(200))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if cpy == head || cpy.Value != 99 {
+		t.Errorf("expected a clone of the list, got %+v", cpy)
+	}
+}
+
+func TestRegisterCloner(t *testing.T) {
+	RegisterCloner(func(v *big.Int) *big.Int {
+		return new(big.Int).Set(v)
+	})
+	t.Cleanup(func() {
+		registry.Delete(reflect.TypeFor[*big.Int]())
+		typeInfoCache.Clear()
+	})
+
+	src := struct {
+		Num  *big.Int
+		Nums []*big.Int
+		Nil  *big.Int
+	}{
+		Num:  big.NewInt(1),
+		Nums: []*big.Int{big.NewInt(2)},
+	}
+
+	cpy := Deep(src)
+
+	src.Num.SetInt64(100)
+	src.Nums[0].SetInt64(100)
+
+	if cpy.Num.Int64() != 1 || cpy.Nums[0].Int64() != 2 {
+		t.Errorf("expected registered cloner to be used, got %v %v", cpy.Num, cpy.Nums)
+	}
+
+	if cpy.Nil != nil {
+		t.Error("expected nil pointer to stay nil")
+	}
+}
+
+func BenchmarkDeep(b *testing.B) {
+	src := holder{
+		Arr:    [2][]string{{"a"}, {"b"}},
+		Map:    map[string][]int{"a": {1}},
+		Nested: []counted{{Data: []int{1}}},
+	}
+
+	b.ReportAllocs()
+
+	for range b.N {
+		_ = Deep(src)
+	}
+}
+
+// box is cloned by [boxCloneHook] like typact.Option is.
+type box struct {
+	val *node
+}
+
+// boxCloneHook implements [types.StateCloner] for box.
+type boxCloneHook struct{}
+
+func (boxCloneHook) Handles(typ reflect.Type) bool {
+	return typ == reflect.TypeFor[box]()
+}
+
+func (boxCloneHook) CloneUsing(src reflect.Value, c types.DeepCloner) (reflect.Value, error) {
+	cloned, err := c.CloneValue(reflect.ValueOf(src.Interface().(box).val))
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	return reflect.ValueOf(box{val: cloned.Interface().(*node)}), nil
+}
+
+// countingAllocator counts the allocations made while cloning.
+type countingAllocator struct {
+	count int
+}
+
+func (ca *countingAllocator) New(typ reflect.Type) reflect.Value {
+	ca.count++
+	return reflect.New(typ)
+}
+
+func (ca *countingAllocator) MakeSlice(typ reflect.Type, length, capacity int) reflect.Value {
+	ca.count++
+	return reflect.MakeSlice(typ, length, capacity)
+}
+
+func (ca *countingAllocator) CloneString(s string) string {
+	ca.count++
+	return strings.Clone(s)
+}
+
+func TestTryDeep_stateCloner(t *testing.T) {
+	prev := types.StateCloneHook
+	types.StateCloneHook = boxCloneHook{}
+	t.Cleanup(func() { types.StateCloneHook = prev })
+
+	root := &node{Value: 1}
+	root.Next = root
+
+	src := []box{{val: root}, {val: root}}
+
+	alloc := &countingAllocator{}
+	cpy, err := TryDeep(src, func(c *config) { c.alloc = alloc })
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if cpy[0].val == root || cpy[0].val.Next != cpy[0].val || cpy[1].val != cpy[0].val {
+		t.Error("expected visited pointers to be shared with the StateCloner")
+	}
+
+	// one allocation for the slice and one for the node
+	if alloc.count != 2 {
+		t.Errorf("expected the allocator to be used 2 times, got %d", alloc.count)
+	}
+
+	_, err = TryDeep(src, WithMaxDepth(2))
+	if !errors.Is(err, ErrMaxDepth) {
+		t.Errorf("expected ErrMaxDepth, got %v", err)
+	}
+}
@@ -0,0 +1,14 @@
+// Package clone provides reflection based deep copies of arbitrary Go values.
+//
+// Types implementing [std.Cloner] are cloned by calling their Clone method,
+// at every depth.
+//
+// The cloning can be customized per struct field using the "typact" struct
+// tag, and per type using [RegisterCloner]:
+//
+//	type Session struct {
+//		User  *User    `typact:"shallow"` // shared with the clone
+//		Cache []string `typact:"skip"`    // zero value in the clone
+//		Data  []byte                      // deep copied
+//	}
+package clone
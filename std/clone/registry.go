@@ -0,0 +1,37 @@
+package clone
+
+import (
+	"reflect"
+	"sync"
+)
+
+// registry holds the functions registered with [RegisterCloner].
+var registry sync.Map // map[reflect.Type]reflect.Value
+
+// RegisterCloner registers fn as the clone function of T.
+// It allows to clone third-party types, like time.Time or big.Int,
+// which do not implement [std.Cloner].
+//
+// Registered functions take precedence over the Clone method of T and
+// are used at every depth. fn is never called with a nil pointer.
+// Registering another function for T replaces the previous one.
+//
+// RegisterCloner is safe for concurrent use, but it should be called
+// during the program initialization, e.g. in an init function.
+func RegisterCloner[T any](fn func(T) T) {
+	registry.Store(reflect.TypeFor[T](), reflect.ValueOf(fn))
+
+	// NOTE: The cached information of other types may depend on T,
+	// thus we have to invalidate the whole cache.
+	typeInfoCache.Clear()
+}
+
+// lookupCloner returns the function registered for typ, if any.
+func lookupCloner(typ reflect.Type) reflect.Value {
+	fn, ok := registry.Load(typ)
+	if !ok {
+		return reflect.Value{}
+	}
+
+	return fn.(reflect.Value)
+}
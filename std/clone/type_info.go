@@ -0,0 +1,201 @@
+package clone
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"go.l0nax.org/typact/internal/types"
+)
+
+// tagName is the name of the struct tag which controls
+// the cloning of a field.
+const tagName = "typact"
+
+// typeInfoCache caches the [typeInfo] per [reflect.Type].
+var typeInfoCache sync.Map // map[reflect.Type]*typeInfo
+
+// fieldPolicy describes how a struct field is cloned.
+type fieldPolicy int
+
+const (
+	// policyDeep deep copies the field.
+	policyDeep fieldPolicy = iota
+	// policyShallow copies the field by value.
+	policyShallow
+	// policySkip sets the field to its zero value.
+	policySkip
+)
+
+// typeInfo holds the cached clone information of a type.
+type typeInfo struct {
+	// cloner is the Clone method, if the type implements [std.Cloner],
+	// or the function registered with [RegisterCloner].
+	cloner reflect.Value
+	// ptrRecv is true if cloner has a pointer receiver.
+	ptrRecv bool
+	// stateful is true if the type is handled by [types.StateCloneHook].
+	stateful bool
+	// needsDeepCopy is false if a value of the type can be copied by value.
+	needsDeepCopy bool
+	// fields holds the struct fields which need to be processed
+	// when cloning a value of the type.
+	fields []fieldInfo
+	// err is set if the type cannot be cloned, e.g. because
+	// of an invalid struct tag.
+	err error
+}
+
+// fieldInfo holds the clone information of a struct field.
+type fieldInfo struct {
+	index    int
+	exported bool
+	policy   fieldPolicy
+}
+
+// getTypeInfo returns the (cached) [typeInfo] of typ.
+func getTypeInfo(typ reflect.Type) *typeInfo {
+	if info, ok := typeInfoCache.Load(typ); ok {
+		return info.(*typeInfo)
+	}
+
+	info := &typeInfo{}
+	registered := lookupCloner(typ)
+
+	switch {
+	case registered.IsValid():
+		info.cloner = registered
+	case typ.Kind() == reflect.Interface:
+		// the dynamic type is checked while cloning
+	case types.StateCloneHook != nil && types.StateCloneHook.Handles(typ):
+		info.stateful = true
+	case hasCloneMethod(typ, typ):
+		mm, _ := typ.MethodByName("Clone")
+		info.cloner = mm.Func
+	case typ.Kind() != reflect.Pointer && hasCloneMethod(reflect.PointerTo(typ), reflect.PointerTo(typ)):
+		mm, _ := reflect.PointerTo(typ).MethodByName("Clone")
+		info.cloner = mm.Func
+		info.ptrRecv = true
+	case typ.Kind() == reflect.Struct:
+		info.fields, info.err = structFields(typ)
+	}
+
+	info.needsDeepCopy = info.cloner.IsValid() || info.stateful || needsDeepCopy(typ, nil)
+
+	actual, _ := typeInfoCache.LoadOrStore(typ, info)
+
+	return actual.(*typeInfo)
+}
+
+// structFields returns the fields of typ which need to be processed
+// when cloning a value of typ.
+func structFields(typ reflect.Type) ([]fieldInfo, error) {
+	var fields []fieldInfo
+
+	for i := range typ.NumField() {
+		fld := typ.Field(i)
+
+		policy, err := parsePolicy(fld.Tag.Get(tagName))
+		if err != nil {
+			return nil, fmt.Errorf("clone: field %s.%s: %w", typ, fld.Name, err)
+		}
+
+		if policy == policyShallow || (policy == policyDeep && !getTypeInfo(fld.Type).needsDeepCopy) {
+			continue
+		}
+
+		fields = append(fields, fieldInfo{
+			index:    i,
+			exported: fld.IsExported(),
+			policy:   policy,
+		})
+	}
+
+	return fields, nil
+}
+
+// parsePolicy parses the value of the "typact" struct tag.
+func parsePolicy(tag string) (fieldPolicy, error) {
+	// NOTE: The tag may be extended with more options in the future,
+	// thus only the first element is used.
+	name, _, _ := strings.Cut(tag, ",")
+
+	switch name {
+	case "":
+		return policyDeep, nil
+	case "shallow":
+		return policyShallow, nil
+	case "skip":
+		return policySkip, nil
+	}
+
+	return policyDeep, fmt.Errorf("invalid %s tag %q", tagName, tag)
+}
+
+// hasCloneMethod reports whether typ has the method Clone() result.
+func hasCloneMethod(typ, result reflect.Type) bool {
+	mm, ok := typ.MethodByName("Clone")
+	if !ok {
+		return false
+	}
+
+	// NOTE: In contains the receiver
+	return mm.Type.NumIn() == 1 && mm.Type.NumOut() == 1 && mm.Type.Out(0) == result
+}
+
+// needsDeepCopy reports whether a shallow copy of a value of typ may
+// share memory with the original value.
+// seen is used to prevent endless recursion.
+func needsDeepCopy(typ reflect.Type, seen map[reflect.Type]bool) bool {
+	if lookupCloner(typ).IsValid() {
+		return true
+	}
+
+	kind := typ.Kind()
+	if isScalarCopyable(kind) || kind == reflect.Chan {
+		return false
+	}
+
+	switch kind {
+	case reflect.Array:
+		return needsDeepCopy(typ.Elem(), seen)
+
+	case reflect.Struct:
+		if seen[typ] {
+			return false
+		}
+
+		if seen == nil {
+			seen = make(map[reflect.Type]bool)
+		}
+
+		seen[typ] = true
+
+		for i := range typ.NumField() {
+			fld := typ.Field(i)
+			ft := fld.Type
+
+			switch policy, err := parsePolicy(fld.Tag.Get(tagName)); {
+			case err != nil, policy == policySkip:
+				// the error is reported by [structFields]
+				return true
+			case policy == policyShallow:
+				continue
+			}
+
+			if hasCloneMethod(ft, ft) || hasCloneMethod(reflect.PointerTo(ft), reflect.PointerTo(ft)) {
+				return true
+			}
+
+			if needsDeepCopy(ft, seen) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	// strings (with arenas), pointers, interfaces, slices and maps
+	return true
+}
@@ -0,0 +1,51 @@
+package cmpop_test
+
+import (
+	"fmt"
+	"slices"
+
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/std/exp/cmpop"
+)
+
+func ExampleThen() {
+	type Employee struct {
+		Name   string
+		Team   string
+		Salary typact.Option[int]
+	}
+
+	employees := []Employee{
+		{Name: "carol", Team: "core", Salary: typact.Some(90)},
+		{Name: "alice", Team: "core"},
+		{Name: "bob", Team: "api", Salary: typact.Some(80)},
+		{Name: "dave", Team: "core", Salary: typact.Some(100)},
+	}
+
+	bySalary := cmpop.CompareFunc(cmpop.NoneLast, func(a, b int) int { return b - a })
+
+	slices.SortFunc(employees, cmpop.Then(
+		cmpop.By(func(e Employee) string { return e.Team }),
+		func(a, b Employee) int { return bySalary(a.Salary, b.Salary) },
+		cmpop.By(func(e Employee) string { return e.Name }),
+	))
+
+	for _, e := range employees {
+		fmt.Println(e.Team, e.Name, e.Salary)
+	}
+
+	// Output:
+	// api bob Some(80)
+	// core dave Some(100)
+	// core carol Some(90)
+	// core alice None
+}
+
+func ExampleMax() {
+	fmt.Println(cmpop.Max(3, 7, 5))
+	fmt.Println(cmpop.Max[int]())
+
+	// Output:
+	// Some(7)
+	// None
+}
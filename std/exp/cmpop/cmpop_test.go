@@ -0,0 +1,183 @@
+package cmpop
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"go.l0nax.org/typact"
+)
+
+type cmpUser struct {
+	Name string
+	Age  int
+}
+
+func TestCompare(t *testing.T) {
+	some1, some2, none := typact.Some(1), typact.Some(2), typact.None[int]()
+
+	tests := []struct {
+		name      string
+		a, b      typact.Option[int]
+		first     Ordering
+		noneLast  Ordering
+		policyCmp Ordering
+	}{
+		{"some less", some1, some2, Less, Less, Greater},
+		{"some equal", some1, some1, Equal, Equal, Equal},
+		{"some greater", some2, some1, Greater, Greater, Less},
+		{"none none", none, none, Equal, Equal, Equal},
+		{"none some", none, some1, Less, Greater, Greater},
+		{"some none", some1, none, Greater, Less, Less},
+	}
+
+	reversed := CompareFunc(NoneLast, Reverse(func(a, b int) int { return a - b }))
+
+	for _, tt := range tests {
+		if got := Compare(tt.a, tt.b); got != tt.first {
+			t.Errorf("%s: Compare: expected %d, got %d", tt.name, tt.first, got)
+		}
+
+		if got := CompareNoneLast(tt.a, tt.b); got != tt.noneLast {
+			t.Errorf("%s: CompareNoneLast: expected %d, got %d", tt.name, tt.noneLast, got)
+		}
+
+		if got := reversed(tt.a, tt.b); got != tt.policyCmp {
+			t.Errorf("%s: CompareFunc: expected %d, got %d", tt.name, tt.policyCmp, got)
+		}
+	}
+}
+
+func TestCompare_sort(t *testing.T) {
+	values := []typact.Option[string]{
+		typact.Some("b"), typact.None[string](), typact.Some("a"), typact.None[string](),
+	}
+
+	slices.SortFunc(values, CompareNoneLast[string])
+
+	expected := []typact.Option[string]{
+		typact.Some("a"), typact.Some("b"), typact.None[string](), typact.None[string](),
+	}
+	if !slices.Equal(values, expected) {
+		t.Errorf("expected %v, got %v", expected, values)
+	}
+}
+
+func TestEqualOption(t *testing.T) {
+	if !EqualOption(typact.Some(1), typact.Some(1)) {
+		t.Error("expected Some(1) to equal Some(1)")
+	}
+
+	if !EqualOption(typact.None[int](), typact.None[int]()) {
+		t.Error("expected None to equal None")
+	}
+
+	if EqualOption(typact.Some(1), typact.Some(2)) || EqualOption(typact.Some(0), typact.None[int]()) {
+		t.Error("expected values to be not equal")
+	}
+
+	now := time.Now()
+	if !EqualOptionEq(typact.Some(now), typact.Some(now.In(time.FixedZone("test", 3600)))) {
+		t.Error("expected the same instant in different locations to be equal")
+	}
+
+	if EqualOptionEq(typact.None[time.Time](), typact.Some(time.Time{})) {
+		t.Error("expected None to not equal Some")
+	}
+
+	if !EqualOptionFunc(typact.Some("FOO"), typact.Some("foo"), strings.EqualFold) {
+		t.Error("expected strings to be equal using strings.EqualFold")
+	}
+}
+
+func TestCombinators(t *testing.T) {
+	users := []cmpUser{
+		{Name: "bob", Age: 30},
+		{Name: "alice", Age: 30},
+		{Name: "carol", Age: 20},
+	}
+
+	slices.SortFunc(users, ThenBy(
+		Reverse(By(func(u cmpUser) int { return u.Age })),
+		func(u cmpUser) string { return u.Name },
+	))
+
+	expected := []cmpUser{
+		{Name: "alice", Age: 30},
+		{Name: "bob", Age: 30},
+		{Name: "carol", Age: 20},
+	}
+	if !slices.Equal(users, expected) {
+		t.Errorf("expected %v, got %v", expected, users)
+	}
+
+	always := func(ret int) func(a, b cmpUser) int {
+		return func(a, b cmpUser) int { return ret }
+	}
+
+	if got := Then(always(Equal), always(Equal), always(Greater))(cmpUser{}, cmpUser{}); got != Greater {
+		t.Errorf("expected Then to return the first non-equal result, got %d", got)
+	}
+
+	if got := Then(always(Equal))(cmpUser{}, cmpUser{}); got != Equal {
+		t.Errorf("expected Then to return Equal, got %d", got)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	if got := Min[int](); got.IsSome() {
+		t.Errorf("expected Min of no values to be None, got %v", got)
+	}
+
+	if got := Max[int](); got.IsSome() {
+		t.Errorf("expected Max of no values to be None, got %v", got)
+	}
+
+	if got := Min(3, 1, 2); got != typact.Some(1) {
+		t.Errorf("expected Some(1), got %v", got)
+	}
+
+	if got := Max(3, 1, 2); got != typact.Some(3) {
+		t.Errorf("expected Some(3), got %v", got)
+	}
+
+	if got := Min(1.0, math.NaN()); !math.IsNaN(got.Unwrap()) {
+		t.Errorf("expected NaN, got %v", got)
+	}
+
+	users := []cmpUser{{"a", 2}, {"b", 1}, {"c", 1}, {"d", 2}}
+	byAge := By(func(u cmpUser) int { return u.Age })
+
+	if got := MinFunc(users, byAge); got != typact.Some(users[1]) {
+		t.Errorf("expected first minimal value, got %v", got)
+	}
+
+	if got := MaxFunc(users, byAge); got != typact.Some(users[0]) {
+		t.Errorf("expected first maximal value, got %v", got)
+	}
+
+	if got := MaxFunc(nil, byAge); got.IsSome() {
+		t.Errorf("expected None, got %v", got)
+	}
+}
+
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		val, lo, hi int
+		expected    typact.Option[int]
+	}{
+		{5, 0, 10, typact.Some(5)},
+		{-5, 0, 10, typact.Some(0)},
+		{15, 0, 10, typact.Some(10)},
+		{5, 5, 5, typact.Some(5)},
+		{5, 10, 0, typact.None[int]()},
+	}
+
+	for _, tt := range tests {
+		if got := Clamp(tt.val, tt.lo, tt.hi); got != tt.expected {
+			t.Errorf("Clamp(%d, %d, %d): expected %v, got %v", tt.val, tt.lo, tt.hi, tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package cmpop
+
+import "cmp"
+
+// By returns a comparison function which compares two values
+// by the key returned by key, using [cmp.Compare].
+//
+//	slices.SortFunc(users, cmpop.By(func(u User) string { return u.Name }))
+func By[T any, K cmp.Ordered](key func(T) K) func(a, b T) Ordering {
+	return func(a, b T) Ordering {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// Reverse returns a comparison function which reverses the order of fn.
+func Reverse[T any](fn func(a, b T) int) func(a, b T) Ordering {
+	return func(a, b T) Ordering {
+		return fn(b, a)
+	}
+}
+
+// Then returns a comparison function which compares two values using fn.
+// If they are equal, the next function of then is used, and so on.
+//
+//	slices.SortFunc(users, cmpop.Then(
+//		cmpop.By(func(u User) string { return u.LastName }),
+//		cmpop.By(func(u User) string { return u.FirstName }),
+//	))
+func Then[T any](fn func(a, b T) int, then ...func(a, b T) int) func(a, b T) Ordering {
+	return func(a, b T) Ordering {
+		if ret := fn(a, b); ret != Equal {
+			return ret
+		}
+
+		for _, next := range then {
+			if ret := next(a, b); ret != Equal {
+				return ret
+			}
+		}
+
+		return Equal
+	}
+}
+
+// ThenBy returns a comparison function which compares two values using fn.
+// If they are equal, they are compared by the key returned by key.
+//
+// It is a shorthand for Then(fn, By(key)).
+func ThenBy[T any, K cmp.Ordered](fn func(a, b T) int, key func(T) K) func(a, b T) Ordering {
+	return Then(fn, By(key))
+}
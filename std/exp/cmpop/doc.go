@@ -1,3 +1,8 @@
 // Package cmpop provides types and functions to easy compare operations.
 // It is an addition to the [cmp] std package.
+//
+// Besides the [Ordering] constants, it provides comparison and equality
+// functions for [typact.Option] values, combinators to build multi-key
+// comparison functions for e.g. [slices.SortFunc] and Option returning
+// variants of min, max and clamp.
 package cmpop
@@ -0,0 +1,79 @@
+package cmpop
+
+import (
+	"cmp"
+
+	"go.l0nax.org/typact"
+)
+
+// Min returns the minimum of values, or [typact.None] if values is empty.
+//
+// For floating-point types, NaN is considered less than any other value,
+// see [cmp.Compare].
+func Min[T cmp.Ordered](values ...T) typact.Option[T] {
+	return MinFunc(values, cmp.Compare[T])
+}
+
+// Max returns the maximum of values, or [typact.None] if values is empty.
+//
+// For floating-point types, NaN is considered less than any other value,
+// see [cmp.Compare].
+func Max[T cmp.Ordered](values ...T) typact.Option[T] {
+	return MaxFunc(values, cmp.Compare[T])
+}
+
+// MinFunc returns the minimal value of values using fn to compare them,
+// or [typact.None] if values is empty.
+// If there are multiple minimal values, the first one is returned.
+func MinFunc[T any](values []T, fn func(a, b T) int) typact.Option[T] {
+	if len(values) == 0 {
+		return typact.None[T]()
+	}
+
+	ret := values[0]
+	for _, val := range values[1:] {
+		if fn(val, ret) < Equal {
+			ret = val
+		}
+	}
+
+	return typact.Some(ret)
+}
+
+// MaxFunc returns the maximal value of values using fn to compare them,
+// or [typact.None] if values is empty.
+// If there are multiple maximal values, the first one is returned.
+func MaxFunc[T any](values []T, fn func(a, b T) int) typact.Option[T] {
+	if len(values) == 0 {
+		return typact.None[T]()
+	}
+
+	ret := values[0]
+	for _, val := range values[1:] {
+		if fn(val, ret) > Equal {
+			ret = val
+		}
+	}
+
+	return typact.Some(ret)
+}
+
+// Clamp returns val limited to the range [lo, hi],
+// or [typact.None] if the range is empty, i.e. lo > hi.
+func Clamp[T cmp.Ordered](val, lo, hi T) typact.Option[T] {
+	return ClampFunc(val, lo, hi, cmp.Compare[T])
+}
+
+// ClampFunc works like [Clamp], but uses fn to compare the values.
+func ClampFunc[T any](val, lo, hi T, fn func(a, b T) int) typact.Option[T] {
+	switch {
+	case fn(lo, hi) > Equal:
+		return typact.None[T]()
+	case fn(val, lo) < Equal:
+		return typact.Some(lo)
+	case fn(val, hi) > Equal:
+		return typact.Some(hi)
+	}
+
+	return typact.Some(val)
+}
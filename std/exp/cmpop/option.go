@@ -0,0 +1,92 @@
+package cmpop
+
+import (
+	"cmp"
+
+	"go.l0nax.org/typact"
+)
+
+// NonePolicy defines where [typact.None] is ordered relative to [typact.Some].
+type NonePolicy int
+
+const (
+	// NoneFirst orders [typact.None] before any [typact.Some] value.
+	NoneFirst NonePolicy = iota
+	// NoneLast orders [typact.None] after any [typact.Some] value.
+	NoneLast
+)
+
+// Eq is implemented by types which define their own equality, e.g. [time.Time].
+type Eq[T any] interface {
+	// Equal reports whether the receiver is equal to other.
+	Equal(other T) bool
+}
+
+// Compare compares the Options a and b.
+// [typact.None] is ordered before any [typact.Some] value,
+// two [typact.Some] values are compared using [cmp.Compare].
+func Compare[T cmp.Ordered](a, b typact.Option[T]) Ordering {
+	return compareOption(a, b, NoneFirst, cmp.Compare[T])
+}
+
+// CompareNoneLast works like [Compare], but orders [typact.None]
+// after any [typact.Some] value.
+func CompareNoneLast[T cmp.Ordered](a, b typact.Option[T]) Ordering {
+	return compareOption(a, b, NoneLast, cmp.Compare[T])
+}
+
+// CompareFunc returns a comparison function for Options, which orders
+// [typact.None] according to policy and compares two [typact.Some]
+// values using fn.
+//
+// The result can be passed to e.g. [slices.SortFunc].
+func CompareFunc[T any](policy NonePolicy, fn func(a, b T) int) func(a, b typact.Option[T]) Ordering {
+	return func(a, b typact.Option[T]) Ordering {
+		return compareOption(a, b, policy, fn)
+	}
+}
+
+// compareOption compares the Options a and b.
+func compareOption[T any](a, b typact.Option[T], policy NonePolicy, fn func(a, b T) int) Ordering {
+	aVal, aOk := a.Deconstruct()
+	bVal, bOk := b.Deconstruct()
+
+	switch {
+	case aOk && bOk:
+		return fn(aVal, bVal)
+	case !aOk && !bOk:
+		return Equal
+	case !aOk == (policy == NoneFirst):
+		return Less
+	}
+
+	return Greater
+}
+
+// EqualOption reports whether the Options a and b are equal, i.e. both
+// are [typact.None] or both are [typact.Some] with equal values.
+//
+// NOTE: The function is not called Equal, since [Equal] is an [Ordering].
+func EqualOption[T comparable](a, b typact.Option[T]) bool {
+	return EqualOptionFunc(a, b, func(a, b T) bool {
+		return a == b
+	})
+}
+
+// EqualOptionEq works like [EqualOption], but compares the values using
+// their [Eq] implementation, e.g. [time.Time.Equal].
+func EqualOptionEq[T Eq[T]](a, b typact.Option[T]) bool {
+	return EqualOptionFunc(a, b, T.Equal)
+}
+
+// EqualOptionFunc works like [EqualOption], but compares the values using eq.
+func EqualOptionFunc[T any](a, b typact.Option[T], eq func(a, b T) bool) bool {
+	aVal, aOk := a.Deconstruct()
+	bVal, bOk := b.Deconstruct()
+
+	if aOk != bOk {
+		return false
+	}
+
+	return !aOk || eq(aVal, bVal)
+}
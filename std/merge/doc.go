@@ -0,0 +1,6 @@
+// Package merge provides functions to layer (merge) structs of [typact.Option]
+// fields, e.g. to combine configuration from defaults, files, environment and flags.
+//
+// Additionally, it provides functions to apply partial updates, e.g. of PATCH
+// endpoints, using [typact.Nullable] fields or JSON Merge Patch (RFC 7396).
+package merge
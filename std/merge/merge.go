@@ -0,0 +1,158 @@
+package merge
+
+import (
+	"fmt"
+	"reflect"
+
+	"go.l0nax.org/typact/std/clone"
+)
+
+// Merger is implemented by custom types which want to control
+// how an overlay value is merged into them.
+type Merger[T any] interface {
+	// Merge merges overlay into the receiver and returns the result.
+	// The receiver and overlay must not be modified.
+	Merge(overlay T) T
+}
+
+// optionLike is implemented by [typact.Option].
+type optionLike interface {
+	IsSome() bool
+}
+
+// optionLikeImpl holds the [reflect.Type] of [optionLike].
+var optionLikeImpl = reflect.TypeOf((*optionLike)(nil)).Elem()
+
+// Merge merges overlay into base and returns the result.
+// Neither base nor overlay are modified.
+//
+// The exported fields of S are merged as follows:
+//
+//   - Types implementing [Merger]: the Merge method is called.
+//   - [typact.Option]: the overlay value is taken if it is [typact.Some],
+//     otherwise the base value.
+//   - Structs without unexported fields: merged recursively.
+//   - Everything else: the overlay value is taken if it is not the zero value,
+//     otherwise the base value.
+//
+// Unexported fields are always taken from base.
+// [typact.Option] values are cloned using [typact.Option.Clone] and all
+// other values, e.g. slices, maps and pointers, using [clone.Deep] to prevent
+// the result from aliasing base or overlay.
+//
+// WARN: This function panics if S is not a struct!
+func Merge[S any](base, overlay S) S {
+	baseVal := reflect.ValueOf(&base).Elem()
+	if baseVal.Kind() != reflect.Struct {
+		panic(fmt.Errorf("unable to merge: type <%v> is not a struct", baseVal.Type()))
+	}
+
+	ret := reflect.New(baseVal.Type()).Elem()
+	ret.Set(baseVal)
+
+	mergeStruct(ret, baseVal, reflect.ValueOf(&overlay).Elem())
+
+	return ret.Interface().(S)
+}
+
+// mergeStruct merges the exported fields of overlay and base into dst.
+// dst must be settable and hold a copy of base.
+func mergeStruct(dst, base, overlay reflect.Value) {
+	typ := dst.Type()
+
+	for i := range typ.NumField() {
+		if !typ.Field(i).IsExported() {
+			continue
+		}
+
+		dst.Field(i).Set(mergeValue(base.Field(i), overlay.Field(i)))
+	}
+}
+
+// mergeValue returns the merged value of base and overlay.
+func mergeValue(base, overlay reflect.Value) reflect.Value {
+	typ := base.Type()
+
+	if fn, ok := mergeMethod(typ); ok {
+		if fn.Type.In(0).Kind() == reflect.Pointer && typ.Kind() != reflect.Pointer {
+			// NOTE: base may not be addressable, thus copy it first.
+			tmp := reflect.New(typ)
+			tmp.Elem().Set(base)
+
+			return fn.Func.Call([]reflect.Value{tmp, overlay})[0]
+		}
+
+		return fn.Func.Call([]reflect.Value{base, overlay})[0]
+	}
+
+	if typ.Implements(optionLikeImpl) {
+		if overlay.Interface().(optionLike).IsSome() {
+			return cloneValue(overlay)
+		}
+
+		return cloneValue(base)
+	}
+
+	if typ.Kind() == reflect.Struct && isMergeable(typ) {
+		ret := reflect.New(typ).Elem()
+		ret.Set(base)
+
+		mergeStruct(ret, base, overlay)
+
+		return ret
+	}
+
+	if overlay.IsZero() {
+		return deepClone(base)
+	}
+
+	return deepClone(overlay)
+}
+
+// mergeMethod returns the Merge method of typ, if typ implements [Merger]
+// with either a value or pointer receiver.
+func mergeMethod(typ reflect.Type) (reflect.Method, bool) {
+	for _, t := range []reflect.Type{typ, reflect.PointerTo(typ)} {
+		mm, ok := t.MethodByName("Merge")
+		if !ok {
+			continue
+		}
+
+		// NOTE: In contains the receiver, i.e. Merge(recv, overlay)
+		if mm.Type.NumIn() == 2 && mm.Type.NumOut() == 1 &&
+			mm.Type.In(1) == typ && mm.Type.Out(0) == typ {
+			return mm, true
+		}
+	}
+
+	return reflect.Method{}, false
+}
+
+// isMergeable reports whether the struct typ can be merged field by field,
+// i.e. it does not contain any unexported fields.
+func isMergeable(typ reflect.Type) bool {
+	for i := range typ.NumField() {
+		if !typ.Field(i).IsExported() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// cloneValue returns a clone of val by calling its Clone method.
+func cloneValue(val reflect.Value) reflect.Value {
+	return val.MethodByName("Clone").Call(nil)[0]
+}
+
+// deepClone returns a deep copy of val using [clone.Deep].
+func deepClone(val reflect.Value) reflect.Value {
+	ret := reflect.New(val.Type()).Elem()
+
+	// NOTE: cloned is nil for nil interface values.
+	if cloned := clone.Deep(val.Interface()); cloned != nil {
+		ret.Set(reflect.ValueOf(cloned))
+	}
+
+	return ret
+}
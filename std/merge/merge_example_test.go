@@ -0,0 +1,78 @@
+package merge_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/std/merge"
+)
+
+type ServerConfig struct {
+	Host typact.Option[string]
+	Port typact.Option[int]
+}
+
+type Config struct {
+	Name   typact.Option[string]
+	Debug  typact.Option[bool]
+	Server ServerConfig
+}
+
+func ExampleMerge() {
+	defaults := Config{
+		Name:  typact.Some("app"),
+		Debug: typact.Some(false),
+		Server: ServerConfig{
+			Host: typact.Some("localhost"),
+			Port: typact.Some(8080),
+		},
+	}
+
+	fromEnv := Config{
+		Debug: typact.Some(true),
+		Server: ServerConfig{
+			Port: typact.Some(9090),
+		},
+	}
+
+	cfg := merge.Merge(defaults, fromEnv)
+	fmt.Println(cfg.Name, cfg.Debug, cfg.Server.Host, cfg.Server.Port)
+
+	// Output:
+	// Some(app) Some(true) Some(localhost) Some(9090)
+}
+
+func ExampleApplyPatch() {
+	type User struct {
+		Name  string
+		Email typact.Option[string]
+		Age   typact.Option[int]
+	}
+
+	// UserPatch distinguishes missing keys (leave as is)
+	// from null values (clear the field).
+	type UserPatch struct {
+		Name  typact.Nullable[string]
+		Email typact.Nullable[string]
+		Age   typact.Nullable[int]
+	}
+
+	user := User{
+		Name:  "gopher",
+		Email: typact.Some("gopher@example.com"),
+		Age:   typact.Some(13),
+	}
+
+	var patch UserPatch
+	_ = json.Unmarshal([]byte(`{"Email": null, "Age": 14}`), &patch)
+
+	if err := merge.ApplyPatch(&user, patch); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(user.Name, user.Email, user.Age)
+
+	// Output:
+	// gopher None Some(14)
+}
@@ -0,0 +1,148 @@
+package merge
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"go.l0nax.org/typact"
+)
+
+type mergeInner struct {
+	Port typact.Option[int]
+}
+
+type mergeLabels map[string]string
+
+// Merge implements [Merger].
+func (m mergeLabels) Merge(overlay mergeLabels) mergeLabels {
+	ret := make(mergeLabels, len(m)+len(overlay))
+	for k, v := range m {
+		ret[k] = v
+	}
+
+	for k, v := range overlay {
+		ret[k] = v
+	}
+
+	return ret
+}
+
+type mergeCounter struct {
+	N int
+}
+
+// Merge implements [Merger] with a pointer receiver.
+func (m *mergeCounter) Merge(overlay mergeCounter) mergeCounter {
+	return mergeCounter{N: m.N + overlay.N}
+}
+
+type mergeData struct {
+	Name     typact.Option[string]
+	Tags     typact.Option[[]string]
+	Inner    mergeInner
+	Labels   mergeLabels
+	Counter  mergeCounter
+	Plain    string
+	At       time.Time
+	internal string
+}
+
+func TestMerge(t *testing.T) {
+	now := time.Now()
+
+	base := mergeData{
+		Name:     typact.Some("base"),
+		Tags:     typact.Some([]string{"a"}),
+		Inner:    mergeInner{Port: typact.Some(80)},
+		Labels:   mergeLabels{"env": "dev", "team": "core"},
+		Counter:  mergeCounter{N: 1},
+		Plain:    "base",
+		internal: "base",
+	}
+	overlay := mergeData{
+		Tags:     typact.Some([]string{"b"}),
+		Labels:   mergeLabels{"env": "prod"},
+		Counter:  mergeCounter{N: 2},
+		At:       now,
+		internal: "overlay",
+	}
+
+	got := Merge(base, overlay)
+	expected := mergeData{
+		Name:     typact.Some("base"),
+		Tags:     typact.Some([]string{"b"}),
+		Inner:    mergeInner{Port: typact.Some(80)},
+		Labels:   mergeLabels{"env": "prod", "team": "core"},
+		Counter:  mergeCounter{N: 3},
+		Plain:    "base",
+		At:       now,
+		internal: "base",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestMerge_noAliasing(t *testing.T) {
+	base := mergeData{}
+	overlay := mergeData{
+		Tags: typact.Some([]string{"a", "b"}),
+	}
+
+	got := Merge(base, overlay)
+	overlay.Tags.Unwrap()[0] = "changed"
+
+	if tags := got.Tags.Unwrap(); tags[0] != "a" {
+		t.Errorf("expected result to not alias overlay, got %v", tags)
+	}
+}
+
+func TestMerge_noAliasingPlain(t *testing.T) {
+	type plain struct {
+		Hosts  []string
+		Limits map[string]int
+		Max    *int
+		Any    any
+	}
+
+	baseMax := 1
+	base := plain{
+		Hosts:  []string{"base"},
+		Limits: map[string]int{"base": 1},
+		Max:    &baseMax,
+	}
+	overlay := plain{
+		Limits: map[string]int{"overlay": 2},
+		Any:    []int{3},
+	}
+
+	got := Merge(base, overlay)
+
+	base.Hosts[0] = "changed"
+	*base.Max = 100
+	overlay.Limits["overlay"] = 200
+	overlay.Any.([]int)[0] = 300
+
+	expected := plain{
+		Hosts:  []string{"base"},
+		Limits: map[string]int{"overlay": 2},
+		Max:    got.Max,
+		Any:    []int{3},
+	}
+
+	if !reflect.DeepEqual(got, expected) || *got.Max != 1 {
+		t.Errorf("expected result to not alias base or overlay, got %+v", got)
+	}
+}
+
+func TestMerge_nonStruct(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Merge to panic")
+		}
+	}()
+
+	Merge(1, 2)
+}
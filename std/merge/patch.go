@@ -0,0 +1,359 @@
+package merge
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// nullableLike is implemented by [typact.Nullable].
+type nullableLike interface {
+	IsUnset() bool
+	IsNull() bool
+}
+
+var (
+	// nullableLikeImpl holds the [reflect.Type] of [nullableLike].
+	nullableLikeImpl = reflect.TypeOf((*nullableLike)(nil)).Elem()
+	// jsonUnmarshalerImpl holds the [reflect.Type] of [json.Unmarshaler].
+	jsonUnmarshalerImpl = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	// textUnmarshalerImpl holds the [reflect.Type] of [encoding.TextUnmarshaler].
+	textUnmarshalerImpl = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// MergePatch applies the JSON Merge Patch patch to the JSON document doc
+// and returns the resulting document, as defined in RFC 7396:
+//
+//   - A null value in patch removes the key from doc.
+//   - Objects are merged recursively.
+//   - Any other value replaces the value in doc.
+func MergePatch(doc, patch []byte) ([]byte, error) {
+	var target any
+	if len(doc) > 0 {
+		if err := json.Unmarshal(doc, &target); err != nil {
+			return nil, fmt.Errorf("unable to decode document: %w", err)
+		}
+	}
+
+	var p any
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, fmt.Errorf("unable to decode patch: %w", err)
+	}
+
+	return json.Marshal(mergePatch(target, p))
+}
+
+// mergePatch implements the MergePatch algorithm of RFC 7396.
+func mergePatch(target, patch any) any {
+	obj, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	doc, ok := target.(map[string]any)
+	if !ok {
+		doc = make(map[string]any, len(obj))
+	}
+
+	for key, val := range obj {
+		if val == nil {
+			delete(doc, key)
+			continue
+		}
+
+		doc[key] = mergePatch(doc[key], val)
+	}
+
+	return doc
+}
+
+// ApplyMergePatch applies the JSON Merge Patch (RFC 7396) patch onto target.
+//
+// target is encoded to JSON, patched using [MergePatch] and decoded
+// into a fresh value of S, thus removed keys result in zero values,
+// e.g. [typact.None]. The "json" struct tags of S are honoured.
+//
+// Fields which are not encoded to JSON, i.e. unexported fields and
+// fields with the `json:"-"` tag, are copied from target, including
+// those of nested structs and of structs referenced by non-nil pointers
+// in both, target and the patched value. Values held by [typact.Option]
+// are decoded from scratch.
+//
+// target is only modified if no error occurs.
+func ApplyMergePatch[S any](target *S, patch []byte) error {
+	doc, err := json.Marshal(target)
+	if err != nil {
+		return fmt.Errorf("unable to encode target: %w", err)
+	}
+
+	doc, err = MergePatch(doc, patch)
+	if err != nil {
+		return err
+	}
+
+	var ret S
+	if err := json.Unmarshal(doc, &ret); err != nil {
+		return fmt.Errorf("unable to decode patched document: %w", err)
+	}
+
+	// NOTE: The hidden fields are copied after decoding, so that
+	// they are copied into the structs allocated by the decoder, too.
+	if val := reflect.ValueOf(&ret).Elem(); val.Kind() == reflect.Struct {
+		copyHidden(val, reflect.ValueOf(target).Elem())
+	}
+
+	*target = ret
+
+	return nil
+}
+
+// copyHidden copies the fields of the addressable struct src, which are
+// not encoded to JSON, into the addressable struct dst.
+//
+// Nested structs are processed recursively, including structs referenced
+// by pointers which are non-nil in both, dst and src.
+func copyHidden(dst, src reflect.Value) {
+	typ := src.Type()
+
+	for i := range typ.NumField() {
+		fld := typ.Field(i)
+		hidden := !fld.IsExported() || fld.Tag.Get("json") == "-"
+
+		switch {
+		case isPlainStruct(fld.Type) && (fld.Anonymous || !hidden):
+			// NOTE: The exported fields of embedded structs are promoted,
+			// even if the embedded struct itself is unexported.
+			copyHidden(dst.Field(i), src.Field(i))
+
+		case isPlainStructPtr(fld.Type) && (fld.Anonymous || !hidden):
+			if d, s := dst.Field(i), src.Field(i); !d.IsNil() && !s.IsNil() {
+				copyHidden(d.Elem(), s.Elem())
+			}
+
+		case hidden:
+			settable(dst.Field(i)).Set(settable(src.Field(i)))
+		}
+	}
+}
+
+// isPlainStruct reports whether typ is a struct which is decoded
+// field by field, i.e. it does not implement a custom unmarshaler.
+func isPlainStruct(typ reflect.Type) bool {
+	ptr := reflect.PointerTo(typ)
+
+	return typ.Kind() == reflect.Struct &&
+		!ptr.Implements(jsonUnmarshalerImpl) &&
+		!ptr.Implements(textUnmarshalerImpl)
+}
+
+// isPlainStructPtr reports whether typ is a pointer to a plain struct,
+// see [isPlainStruct].
+func isPlainStructPtr(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Pointer && isPlainStruct(typ.Elem())
+}
+
+// settable returns val, which must be addressable, so that it can be
+// set even if it is an unexported field.
+func settable(val reflect.Value) reflect.Value {
+	return reflect.NewAt(val.Type(), unsafe.Pointer(val.UnsafeAddr())).Elem()
+}
+
+// ApplyPatch applies the struct patch onto the struct target.
+//
+// The exported fields of patch are matched by name with the fields of target.
+// [typact.Nullable] fields are applied as follows:
+//
+//   - [typact.Unset]: the target field is left untouched.
+//   - [typact.Null]: the target field is set to its zero value, e.g. [typact.None] or nil.
+//   - [typact.Value]: the value is assigned to the target field. If the target
+//     field is a [typact.Option] or a pointer, the value is wrapped accordingly.
+//     If the value is a struct containing [typact.Nullable] fields itself, it is
+//     applied recursively, as with objects in JSON Merge Patch.
+//
+// [typact.Option] fields in patch are assigned if they are [typact.Some].
+// All other fields of patch are ignored.
+//
+// target is only modified if no error occurs.
+//
+// WARN: This function panics if S or P is not a struct!
+func ApplyPatch[S, P any](target *S, patch P) error {
+	dst := reflect.New(reflect.TypeFor[S]()).Elem()
+	dst.Set(reflect.ValueOf(target).Elem())
+
+	src := reflect.ValueOf(&patch).Elem()
+	if dst.Kind() != reflect.Struct || src.Kind() != reflect.Struct {
+		panic(fmt.Errorf("unable to apply patch: types <%v> and <%v> must be structs", dst.Type(), src.Type()))
+	}
+
+	if err := applyStruct(dst, src); err != nil {
+		return err
+	}
+
+	*target = dst.Interface().(S)
+
+	return nil
+}
+
+// applyStruct applies the struct patch onto the addressable struct dst.
+func applyStruct(dst, patch reflect.Value) error {
+	typ := patch.Type()
+
+	for i := range typ.NumField() {
+		fld := typ.Field(i)
+		if !fld.IsExported() {
+			continue
+		}
+
+		val := patch.Field(i)
+
+		switch {
+		case fld.Type.Implements(nullableLikeImpl):
+			n := val.Interface().(nullableLike)
+			if n.IsUnset() {
+				continue
+			}
+
+			target, err := targetField(dst, fld.Name)
+			if err != nil {
+				return err
+			}
+
+			if n.IsNull() {
+				target.SetZero()
+				continue
+			}
+
+			if err := assignValue(target, val.MethodByName("UnsafeUnwrap").Call(nil)[0]); err != nil {
+				return fmt.Errorf("unable to apply field %s: %w", fld.Name, err)
+			}
+
+		case fld.Type.Implements(optionLikeImpl):
+			if !val.Interface().(optionLike).IsSome() {
+				continue
+			}
+
+			target, err := targetField(dst, fld.Name)
+			if err != nil {
+				return err
+			}
+
+			if err := assignValue(target, val.MethodByName("UnsafeUnwrap").Call(nil)[0]); err != nil {
+				return fmt.Errorf("unable to apply field %s: %w", fld.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// targetField returns the exported field name of dst.
+func targetField(dst reflect.Value, name string) (reflect.Value, error) {
+	fld, ok := dst.Type().FieldByName(name)
+	if !ok || !fld.IsExported() || len(fld.Index) != 1 {
+		return reflect.Value{}, fmt.Errorf("unable to apply field %s: not found in <%v>", name, dst.Type())
+	}
+
+	return dst.Field(fld.Index[0]), nil
+}
+
+// assignValue assigns val to the addressable dst.
+func assignValue(dst, val reflect.Value) error {
+	if isPatch(val.Type()) {
+		return applyNested(dst, val)
+	}
+
+	typ := dst.Type()
+
+	switch {
+	case val.Type().AssignableTo(typ):
+		dst.Set(val)
+
+	case typ.Implements(optionLikeImpl) && isOptionOf(typ, val.Type()):
+		dst.Addr().MethodByName("Insert").Call([]reflect.Value{val})
+
+	case typ.Kind() == reflect.Pointer && val.Type().AssignableTo(typ.Elem()):
+		ptr := reflect.New(typ.Elem())
+		ptr.Elem().Set(val)
+		dst.Set(ptr)
+
+	default:
+		return fmt.Errorf("type <%v> is not assignable to <%v>", val.Type(), typ)
+	}
+
+	return nil
+}
+
+// applyNested applies the struct patch onto dst, which is either a struct,
+// a pointer to a struct or a [typact.Option] of a struct.
+func applyNested(dst, patch reflect.Value) error {
+	typ := dst.Type()
+
+	switch {
+	case typ.Kind() == reflect.Struct && !typ.Implements(optionLikeImpl):
+		return applyStruct(dst, patch)
+
+	case typ.Kind() == reflect.Pointer && typ.Elem().Kind() == reflect.Struct:
+		// NOTE: We must not modify the value dst points to,
+		// since it may be shared with other values.
+		ptr := reflect.New(typ.Elem())
+		if !dst.IsNil() {
+			ptr.Elem().Set(dst.Elem())
+		}
+
+		if err := applyStruct(ptr.Elem(), patch); err != nil {
+			return err
+		}
+
+		dst.Set(ptr)
+
+		return nil
+
+	case typ.Implements(optionLikeImpl):
+		inner := reflect.New(optionElem(typ)).Elem()
+		if inner.Kind() != reflect.Struct {
+			break
+		}
+
+		inner.Set(dst.MethodByName("UnwrapOrZero").Call(nil)[0])
+
+		if err := applyStruct(inner, patch); err != nil {
+			return err
+		}
+
+		dst.Addr().MethodByName("Insert").Call([]reflect.Value{inner})
+
+		return nil
+	}
+
+	return fmt.Errorf("unable to apply patch <%v> onto non-struct type <%v>", patch.Type(), typ)
+}
+
+// isPatch reports whether typ is a struct with at least one [typact.Nullable] field.
+func isPatch(typ reflect.Type) bool {
+	if typ.Kind() != reflect.Struct {
+		return false
+	}
+
+	for i := range typ.NumField() {
+		if typ.Field(i).Type.Implements(nullableLikeImpl) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isOptionOf reports whether the [typact.Option] type opt can hold a value of typ.
+func isOptionOf(opt, typ reflect.Type) bool {
+	return typ.AssignableTo(optionElem(opt))
+}
+
+// optionElem returns the type of the value the [typact.Option] type opt holds.
+func optionElem(opt reflect.Type) reflect.Type {
+	mm, _ := opt.MethodByName("UnsafeUnwrap")
+
+	// NOTE: In contains the receiver
+	return mm.Type.Out(0)
+}
@@ -0,0 +1,301 @@
+package merge
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"go.l0nax.org/typact"
+)
+
+type patchAddress struct {
+	City typact.Option[string] `json:"city"`
+	Zip  string                `json:"zip"`
+}
+
+type patchUser struct {
+	Name     string                      `json:"name"`
+	Email    typact.Option[string]       `json:"email"`
+	Age      *int                        `json:"age"`
+	Address  patchAddress                `json:"address"`
+	Billing  typact.Option[patchAddress] `json:"billing"`
+	Shipping *patchAddress               `json:"shipping"`
+	Tags     []string                    `json:"tags"`
+}
+
+type patchAddressPatch struct {
+	City typact.Nullable[string]
+	Zip  typact.Nullable[string]
+}
+
+type patchUserPatch struct {
+	Name     typact.Nullable[string]
+	Email    typact.Nullable[string]
+	Age      typact.Nullable[int]
+	Address  typact.Nullable[patchAddressPatch]
+	Billing  typact.Nullable[patchAddressPatch]
+	Shipping typact.Nullable[patchAddressPatch]
+	Tags     typact.Option[[]string]
+	Ignored  string
+}
+
+func TestMergePatch(t *testing.T) {
+	// test cases of RFC 7396, Appendix A
+	tests := []struct {
+		doc, patch, expected string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+		{``, `{"a":"b"}`, `{"a":"b"}`},
+	}
+
+	for _, tt := range tests {
+		got, err := MergePatch([]byte(tt.doc), []byte(tt.patch))
+		if err != nil {
+			t.Fatalf("MergePatch(%s, %s): unexpected error: %v", tt.doc, tt.patch, err)
+		}
+
+		if string(got) != tt.expected {
+			t.Errorf("MergePatch(%s, %s): expected %s, got %s", tt.doc, tt.patch, tt.expected, got)
+		}
+	}
+}
+
+func TestMergePatch_invalid(t *testing.T) {
+	if _, err := MergePatch([]byte(`{`), []byte(`{}`)); err == nil {
+		t.Error("expected error for invalid document")
+	}
+
+	if _, err := MergePatch([]byte(`{}`), []byte(`{`)); err == nil {
+		t.Error("expected error for invalid patch")
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	user := patchUser{
+		Name:    "gopher",
+		Email:   typact.Some("gopher@example.com"),
+		Address: patchAddress{City: typact.Some("Berlin"), Zip: "10115"},
+		Tags:    []string{"a"},
+	}
+
+	err := ApplyMergePatch(&user, []byte(`{"email":null,"address":{"city":"Hamburg"},"billing":{"zip":"1"}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := patchUser{
+		Name:    "gopher",
+		Address: patchAddress{City: typact.Some("Hamburg"), Zip: "10115"},
+		Billing: typact.Some(patchAddress{Zip: "1"}),
+		Tags:    []string{"a"},
+	}
+
+	if !reflect.DeepEqual(user, expected) {
+		t.Errorf("expected %+v, got %+v", expected, user)
+	}
+}
+
+func TestApplyMergePatch_hiddenFields(t *testing.T) {
+	type inner struct {
+		City   string `json:"city"`
+		secret string
+	}
+
+	type embedded struct {
+		Zip   string `json:"zip"`
+		state int
+	}
+
+	type account struct {
+		embedded
+
+		Name     string `json:"name"`
+		Password string `json:"-"`
+		Inner    inner  `json:"inner"`
+		version  int
+	}
+
+	target := account{
+		embedded: embedded{Zip: "10115", state: 2},
+		Name:     "gopher",
+		Password: "hunter2",
+		Inner:    inner{City: "Berlin", secret: "s3cr3t"},
+		version:  3,
+	}
+
+	err := ApplyMergePatch(&target, []byte(`{"name":"patched","zip":null,"inner":{"city":"Hamburg"}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := account{
+		embedded: embedded{state: 2},
+		Name:     "patched",
+		Password: "hunter2",
+		Inner:    inner{City: "Hamburg", secret: "s3cr3t"},
+		version:  3,
+	}
+
+	if !reflect.DeepEqual(target, expected) {
+		t.Errorf("expected %+v, got %+v", expected, target)
+	}
+}
+
+func TestApplyMergePatch_hiddenFieldsPointer(t *testing.T) {
+	type inner struct {
+		City   string `json:"city"`
+		secret string
+	}
+
+	type account struct {
+		Inner   *inner `json:"inner"`
+		Removed *inner `json:"removed"`
+	}
+
+	target := account{
+		Inner:   &inner{City: "Berlin", secret: "s3cr3t"},
+		Removed: &inner{City: "Bonn", secret: "s3cr3t"},
+	}
+	prev := target.Inner
+
+	err := ApplyMergePatch(&target, []byte(`{"inner":{"city":"Hamburg"},"removed":null}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := account{
+		Inner: &inner{City: "Hamburg", secret: "s3cr3t"},
+	}
+
+	if !reflect.DeepEqual(target, expected) {
+		t.Errorf("expected %+v, got %+v", expected, target)
+	}
+
+	if prev.City != "Berlin" {
+		t.Error("expected the previous value not to be modified")
+	}
+}
+
+func TestApplyMergePatch_invalid(t *testing.T) {
+	user := patchUser{Name: "gopher"}
+
+	if err := ApplyMergePatch(&user, []byte(`{"name":1}`)); err == nil {
+		t.Error("expected error for invalid type")
+	}
+
+	if user.Name != "gopher" {
+		t.Errorf("expected target to be untouched, got %+v", user)
+	}
+}
+
+func TestApplyPatch(t *testing.T) {
+	shipping := &patchAddress{Zip: "20095"}
+	user := patchUser{
+		Name:     "gopher",
+		Email:    typact.Some("gopher@example.com"),
+		Age:      new(int),
+		Address:  patchAddress{City: typact.Some("Berlin"), Zip: "10115"},
+		Shipping: shipping,
+		Tags:     []string{"a"},
+	}
+
+	var patch patchUserPatch
+	err := json.Unmarshal([]byte(`{
+		"Email": null,
+		"Age": 42,
+		"Address": {"City": "Hamburg"},
+		"Billing": {"Zip": "1"},
+		"Shipping": {"City": null, "Zip": "20097"},
+		"Tags": ["b"],
+		"Ignored": "foo"
+	}`), &patch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := ApplyPatch(&user, patch); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	age := 42
+	expected := patchUser{
+		Name:     "gopher",
+		Age:      &age,
+		Address:  patchAddress{City: typact.Some("Hamburg"), Zip: "10115"},
+		Billing:  typact.Some(patchAddress{Zip: "1"}),
+		Shipping: &patchAddress{Zip: "20097"},
+		Tags:     []string{"b"},
+	}
+
+	if !reflect.DeepEqual(user, expected) {
+		t.Errorf("expected %+v, got %+v", expected, user)
+	}
+
+	if shipping.Zip != "20095" {
+		t.Errorf("expected previous pointer value to be untouched, got %+v", shipping)
+	}
+}
+
+func TestApplyPatch_null(t *testing.T) {
+	user := patchUser{
+		Name:    "gopher",
+		Billing: typact.Some(patchAddress{Zip: "1"}),
+		Address: patchAddress{Zip: "1"},
+	}
+
+	patch := patchUserPatch{
+		Name:    typact.Null[string](),
+		Billing: typact.Null[patchAddressPatch](),
+		Address: typact.Null[patchAddressPatch](),
+	}
+
+	if err := ApplyPatch(&user, patch); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(user, patchUser{}) {
+		t.Errorf("expected zero value, got %+v", user)
+	}
+}
+
+func TestApplyPatch_invalid(t *testing.T) {
+	user := patchUser{Name: "gopher"}
+
+	err := ApplyPatch(&user, struct {
+		Name    typact.Nullable[string]
+		Unknown typact.Nullable[int]
+	}{
+		Name:    typact.Value("changed"),
+		Unknown: typact.Value(1),
+	})
+	if err == nil {
+		t.Error("expected error for unknown field")
+	}
+
+	err = ApplyPatch(&user, struct {
+		Name typact.Nullable[int]
+	}{
+		Name: typact.Value(1),
+	})
+	if err == nil {
+		t.Error("expected error for mismatching type")
+	}
+
+	if user.Name != "gopher" {
+		t.Errorf("expected target to be untouched, got %+v", user)
+	}
+}
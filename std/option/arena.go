@@ -0,0 +1,24 @@
+//go:build go1.20 && goexperiment.arenas
+// +build go1.20,goexperiment.arenas
+
+package option
+
+import (
+	"arena"
+
+	"go.l0nax.org/typact"
+)
+
+// NewIn allocates a new [typact.Option] holding v in a and
+// returns a pointer to it.
+//
+// Only the Option itself is allocated in a, v is stored as is.
+// Use [typact.Option.CloneInto] to deep copy v into a.
+//
+// WARN: The returned pointer must not be used after a has been freed!
+func NewIn[T any](a *arena.Arena, v T) *typact.Option[T] {
+	opt := arena.New[typact.Option[T]](a)
+	*opt = typact.Some(v)
+
+	return opt
+}
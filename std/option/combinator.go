@@ -0,0 +1,61 @@
+package option
+
+import "go.l0nax.org/typact"
+
+// AndThen returns [typact.None] if src is [typact.None], otherwise fn is called
+// with the value of src and the result is returned.
+//
+// In contrast to [typact.Option.AndThen], fn may return a different type.
+func AndThen[T any, K any](src typact.Option[T], fn func(T) typact.Option[K]) typact.Option[K] {
+	if src.IsSome() {
+		return fn(src.UnsafeUnwrap())
+	}
+
+	return typact.None[K]()
+}
+
+// Flatten removes one level of nesting from src.
+func Flatten[T any](src typact.Option[typact.Option[T]]) typact.Option[T] {
+	if src.IsSome() {
+		return src.UnsafeUnwrap()
+	}
+
+	return typact.None[T]()
+}
+
+// Xor returns [typact.Some] if exactly one of a and b is [typact.Some].
+// Otherwise [typact.None] is returned.
+func Xor[T any](a, b typact.Option[T]) typact.Option[T] {
+	switch {
+	case a.IsSome() && b.IsNone():
+		return a
+	case a.IsNone() && b.IsSome():
+		return b
+	}
+
+	return typact.None[T]()
+}
+
+// OkOr transforms src into the idiomatic (T, error) pair.
+// If src is [typact.None], the zero value of T and err are returned.
+func OkOr[T any](src typact.Option[T], err error) (T, error) {
+	if src.IsSome() {
+		return src.UnsafeUnwrap(), nil
+	}
+
+	var zero T
+
+	return zero, err
+}
+
+// OkOrElse transforms src into the idiomatic (T, error) pair.
+// If src is [typact.None], the zero value of T and the error returned by fn are returned.
+func OkOrElse[T any](src typact.Option[T], fn func() error) (T, error) {
+	if src.IsSome() {
+		return src.UnsafeUnwrap(), nil
+	}
+
+	var zero T
+
+	return zero, fn()
+}
@@ -0,0 +1,11 @@
+// Package envflag binds [typact.Option] values to command line flags
+// and environment variables.
+//
+// In contrast to plain values, an [typact.Option] stays [typact.None] if
+// the flag has not been passed or the environment variable is not set,
+// which allows to tell whether an operator configured a value.
+//
+// Values are parsed using [typact.Option.UnmarshalText], thus T must
+// be a scalar type, a [time.Duration], a named type with a scalar
+// underlying type or implement [encoding.TextUnmarshaler].
+package envflag
@@ -0,0 +1,117 @@
+package envflag
+
+import (
+	"encoding"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// tagName is the name of the struct tag which overrides the
+// name of the environment variable of a field.
+const tagName = "env"
+
+// optionLike is implemented by [typact.Option].
+type optionLike interface {
+	IsSome() bool
+}
+
+var (
+	// optionLikeImpl holds the [reflect.Type] of [optionLike].
+	optionLikeImpl = reflect.TypeOf((*optionLike)(nil)).Elem()
+	// textUnmarshalerImpl holds the [reflect.Type] of [encoding.TextUnmarshaler].
+	textUnmarshalerImpl = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// LoadEnv fills the [typact.Option] fields of the struct cfg points to
+// from environment variables.
+//
+// The name of the environment variable is prefix followed by the field name
+// in upper snake case, e.g. the field "HTTPPort" with the prefix "APP_"
+// is read from "APP_HTTP_PORT". The name can be overridden with the "env"
+// struct tag, which is not prefixed, or the field can be skipped with `env:"-"`.
+//
+// Fields of nested structs are loaded recursively, with the field name
+// appended to the prefix, e.g. "APP_SERVER_PORT".
+//
+// Fields are only set if the environment variable is set, otherwise
+// they are left untouched. Other fields, which are neither an [typact.Option]
+// nor a struct, are ignored. The values are parsed using [typact.Option.UnmarshalText].
+//
+// WARN: This function panics if cfg is not a pointer to a struct!
+func LoadEnv(cfg any, prefix string) error {
+	val := reflect.ValueOf(cfg)
+	if val.Kind() != reflect.Pointer || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		panic(fmt.Errorf("unable to load environment: type <%T> is not a pointer to a struct", cfg))
+	}
+
+	return loadStruct(val.Elem(), prefix)
+}
+
+// loadStruct loads the fields of the addressable struct val.
+func loadStruct(val reflect.Value, prefix string) error {
+	typ := val.Type()
+
+	for i := range typ.NumField() {
+		fld := typ.Field(i)
+		if !fld.IsExported() {
+			continue
+		}
+
+		tag := fld.Tag.Get(tagName)
+		if tag == "-" {
+			continue
+		}
+
+		name := tag
+		if name == "" {
+			name = prefix + envName(fld.Name)
+		}
+
+		switch {
+		case fld.Type.Implements(optionLikeImpl) && reflect.PointerTo(fld.Type).Implements(textUnmarshalerImpl):
+			raw, ok := os.LookupEnv(name)
+			if !ok {
+				continue
+			}
+
+			dec := val.Field(i).Addr().Interface().(encoding.TextUnmarshaler)
+			if err := dec.UnmarshalText([]byte(raw)); err != nil {
+				return fmt.Errorf("unable to load environment variable %s: %w", name, err)
+			}
+
+		case fld.Type.Kind() == reflect.Struct:
+			if err := loadStruct(val.Field(i), name+"_"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// envName converts the field name into upper snake case,
+// e.g. "HTTPPort" into "HTTP_PORT".
+func envName(name string) string {
+	runes := []rune(name)
+
+	var sb strings.Builder
+	sb.Grow(len(name) + 4)
+
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				sb.WriteByte('_')
+			}
+		}
+
+		sb.WriteRune(unicode.ToUpper(r))
+	}
+
+	return sb.String()
+}
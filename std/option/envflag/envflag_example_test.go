@@ -0,0 +1,48 @@
+package envflag_test
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/std/option/envflag"
+)
+
+func ExampleLoadEnv() {
+	type Config struct {
+		Host typact.Option[string]
+		Port typact.Option[int]
+	}
+
+	os.Setenv("APP_PORT", "8080")
+	defer os.Unsetenv("APP_PORT")
+
+	var cfg Config
+	if err := envflag.LoadEnv(&cfg, "APP_"); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(cfg.Host, cfg.Port)
+
+	// Output:
+	// None Some(8080)
+}
+
+func ExampleNewValue() {
+	var (
+		host typact.Option[string]
+		port typact.Option[int]
+	)
+
+	fs := flag.NewFlagSet("example", flag.ExitOnError)
+	fs.Var(envflag.NewValue(&host), "host", "host to listen on")
+	fs.Var(envflag.NewValue(&port), "port", "port to listen on")
+
+	_ = fs.Parse([]string{"-port", "8080"})
+
+	fmt.Println(host, port)
+
+	// Output:
+	// None Some(8080)
+}
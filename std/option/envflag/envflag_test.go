@@ -0,0 +1,257 @@
+package envflag
+
+import (
+	"flag"
+	"io"
+	"testing"
+	"time"
+
+	"go.l0nax.org/typact"
+)
+
+type envServer struct {
+	Host typact.Option[string]
+	Port typact.Option[uint16]
+}
+
+type envLevel int8
+
+type envConfig struct {
+	Name     typact.Option[string]
+	HTTPPort typact.Option[int]
+	Debug    typact.Option[bool]
+	Timeout  typact.Option[time.Duration]
+	Workers  typact.Option[uint]
+	Level    typact.Option[envLevel]
+	Since    typact.Option[time.Time]
+	Token    typact.Option[string] `env:"SECRET_TOKEN"`
+	Skipped  typact.Option[string] `env:"-"`
+	Server   envServer
+	Plain    string
+
+	unexported typact.Option[string]
+}
+
+func TestEnvName(t *testing.T) {
+	tests := map[string]string{
+		"Name":      "NAME",
+		"HTTPPort":  "HTTP_PORT",
+		"MaxConns":  "MAX_CONNS",
+		"TLSConfig": "TLS_CONFIG",
+		"ID":        "ID",
+		"UserID":    "USER_ID",
+		"V2Enabled": "V2_ENABLED",
+		"S3":        "S3",
+	}
+
+	for name, expected := range tests {
+		if got := envName(name); got != expected {
+			t.Errorf("envName(%q): expected %q, got %q", name, expected, got)
+		}
+	}
+}
+
+func TestLoadEnv(t *testing.T) {
+	t.Setenv("APP_NAME", "app")
+	t.Setenv("APP_HTTP_PORT", "8080")
+	t.Setenv("APP_DEBUG", "false")
+	t.Setenv("APP_TIMEOUT", "1m30s")
+	t.Setenv("APP_WORKERS", "4")
+	t.Setenv("APP_LEVEL", "-2")
+	t.Setenv("APP_SINCE", "2024-12-23T10:00:00Z")
+	t.Setenv("SECRET_TOKEN", "secret")
+	t.Setenv("APP_TOKEN", "ignored")
+	t.Setenv("APP_SKIPPED", "ignored")
+	t.Setenv("APP_SERVER_PORT", "443")
+	t.Setenv("APP_PLAIN", "ignored")
+	t.Setenv("APP_UNEXPORTED", "ignored")
+
+	cfg := envConfig{
+		Server: envServer{Host: typact.Some("localhost")},
+	}
+
+	if err := LoadEnv(&cfg, "APP_"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.Name != typact.Some("app") {
+		t.Errorf("expected Name to be Some(app), got %v", cfg.Name)
+	}
+
+	if cfg.HTTPPort != typact.Some(8080) {
+		t.Errorf("expected HTTPPort to be Some(8080), got %v", cfg.HTTPPort)
+	}
+
+	if cfg.Debug != typact.Some(false) {
+		t.Errorf("expected Debug to be Some(false), got %v", cfg.Debug)
+	}
+
+	if cfg.Timeout != typact.Some(90*time.Second) {
+		t.Errorf("expected Timeout to be Some(1m30s), got %v", cfg.Timeout)
+	}
+
+	if cfg.Workers != typact.Some[uint](4) {
+		t.Errorf("expected Workers to be Some(4), got %v", cfg.Workers)
+	}
+
+	if cfg.Level != typact.Some[envLevel](-2) {
+		t.Errorf("expected Level to be Some(-2), got %v", cfg.Level)
+	}
+
+	if !cfg.Since.Unwrap().Equal(time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected Since: %v", cfg.Since)
+	}
+
+	if cfg.Token != typact.Some("secret") {
+		t.Errorf("expected Token to be Some(secret), got %v", cfg.Token)
+	}
+
+	if cfg.Skipped.IsSome() || cfg.unexported.IsSome() || cfg.Plain != "" {
+		t.Errorf("expected fields to be ignored, got %+v", cfg)
+	}
+
+	if cfg.Server.Host != typact.Some("localhost") || cfg.Server.Port != typact.Some[uint16](443) {
+		t.Errorf("unexpected Server: %+v", cfg.Server)
+	}
+}
+
+func TestLoadEnv_invalid(t *testing.T) {
+	t.Setenv("HTTP_PORT", "foo")
+
+	var cfg envConfig
+	if err := LoadEnv(&cfg, ""); err == nil {
+		t.Error("expected error for invalid value")
+	}
+}
+
+func TestLoadEnv_invalidDuration(t *testing.T) {
+	t.Setenv("TIMEOUT", "10")
+
+	var cfg envConfig
+	if err := LoadEnv(&cfg, ""); err == nil {
+		t.Error("expected error for invalid duration")
+	}
+
+	if cfg.Timeout.IsSome() {
+		t.Errorf("expected Timeout to be None, got %v", cfg.Timeout)
+	}
+}
+
+func TestLoadEnv_nonStruct(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected LoadEnv to panic")
+		}
+	}()
+
+	var cfg envConfig
+	_ = LoadEnv(cfg, "")
+}
+
+func TestValue(t *testing.T) {
+	var (
+		name  typact.Option[string]
+		port  typact.Option[int]
+		debug typact.Option[bool]
+		level typact.Option[uint8]
+	)
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(NewValue(&name), "name", "")
+	fs.Var(NewValue(&port), "port", "")
+	fs.Var(NewValue(&debug), "debug", "")
+	fs.Var(NewValue(&level), "level", "")
+
+	if err := fs.Parse([]string{"-name", "", "-port=8080", "-debug"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if name != typact.Some("") {
+		t.Errorf("expected name to be Some(), got %v", name)
+	}
+
+	if port != typact.Some(8080) {
+		t.Errorf("expected port to be Some(8080), got %v", port)
+	}
+
+	if debug != typact.Some(true) {
+		t.Errorf("expected debug to be Some(true), got %v", debug)
+	}
+
+	if level.IsSome() {
+		t.Errorf("expected level to be None, got %v", level)
+	}
+
+	if got := fs.Lookup("port").Value.String(); got != "8080" {
+		t.Errorf("expected String to return 8080, got %q", got)
+	}
+
+	if got := fs.Lookup("level").Value.String(); got != "" {
+		t.Errorf("expected String to return an empty string, got %q", got)
+	}
+
+	if got := fs.Lookup("port").Value.(flag.Getter).Get(); got != typact.Some(8080) {
+		t.Errorf("expected Get to return Some(8080), got %v", got)
+	}
+}
+
+func TestFlagVar(t *testing.T) {
+	orig := flag.CommandLine
+	t.Cleanup(func() {
+		flag.CommandLine = orig
+	})
+
+	flag.CommandLine = flag.NewFlagSet("test", flag.ContinueOnError)
+	flag.CommandLine.SetOutput(io.Discard)
+
+	var (
+		timeout typact.Option[time.Duration]
+		workers typact.Option[uint]
+		level   typact.Option[envLevel]
+	)
+
+	FlagVar(&timeout, "timeout", "")
+	FlagVar(&workers, "workers", "")
+	FlagVar(&level, "level", "")
+
+	if err := flag.CommandLine.Parse([]string{"-timeout=2s", "-workers", "8", "-level=3"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if timeout != typact.Some(2*time.Second) {
+		t.Errorf("expected timeout to be Some(2s), got %v", timeout)
+	}
+
+	if workers != typact.Some[uint](8) {
+		t.Errorf("expected workers to be Some(8), got %v", workers)
+	}
+
+	if level != typact.Some[envLevel](3) {
+		t.Errorf("expected level to be Some(3), got %v", level)
+	}
+
+	if got := flag.Lookup("timeout").Value.String(); got != "2s" {
+		t.Errorf("expected String to return 2s, got %q", got)
+	}
+
+	if got := flag.Lookup("level").Value.String(); got != "3" {
+		t.Errorf("expected String to return 3, got %q", got)
+	}
+}
+
+func TestValue_invalid(t *testing.T) {
+	var port typact.Option[int]
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(NewValue(&port), "port", "")
+
+	if err := fs.Parse([]string{"-port=foo"}); err == nil {
+		t.Error("expected error for invalid value")
+	}
+
+	if port.IsSome() {
+		t.Errorf("expected port to be None, got %v", port)
+	}
+}
@@ -0,0 +1,69 @@
+package envflag
+
+import (
+	"flag"
+
+	"go.l0nax.org/typact"
+)
+
+// Value implements the [flag.Value] interface for an [typact.Option].
+type Value[T any] struct {
+	opt *typact.Option[T]
+}
+
+// NewValue returns a [flag.Value] which stores the parsed flag value in opt.
+// opt is left untouched until the flag is passed.
+//
+// Use it to register the flag in a custom [flag.FlagSet]:
+//
+//	fs.Var(envflag.NewValue(&cfg.Port), "port", "port to listen on")
+func NewValue[T any](opt *typact.Option[T]) *Value[T] {
+	return &Value[T]{
+		opt: opt,
+	}
+}
+
+// FlagVar defines a flag with the specified name and usage string
+// in [flag.CommandLine]. The parsed value is stored in opt, which
+// is left untouched if the flag is not passed.
+func FlagVar[T any](opt *typact.Option[T], name, usage string) {
+	flag.CommandLine.Var(NewValue(opt), name, usage)
+}
+
+// String implements the [flag.Value] interface.
+// It returns an empty string if the value is [typact.None].
+func (v *Value[T]) String() string {
+	// NOTE: The flag package calls String on the zero value
+	// to determine the default value.
+	if v == nil || v.opt == nil || v.opt.IsNone() {
+		return ""
+	}
+
+	text, err := v.opt.MarshalText()
+	if err != nil {
+		return v.opt.String()
+	}
+
+	return string(text)
+}
+
+// Set implements the [flag.Value] interface.
+// The value is parsed using [typact.Option.UnmarshalText].
+func (v *Value[T]) Set(s string) error {
+	return v.opt.UnmarshalText([]byte(s))
+}
+
+// Get implements the [flag.Getter] interface.
+// It returns the stored [typact.Option].
+func (v *Value[T]) Get() any {
+	return *v.opt
+}
+
+// IsBoolFlag reports whether T is a bool, which allows
+// to pass the flag without a value, e.g. "-debug".
+func (v *Value[T]) IsBoolFlag() bool {
+	_, ok := any(v.opt.UnsafeUnwrap()).(bool)
+	return ok
+}
+
+var _ flag.Getter = (*Value[int])(nil)
@@ -0,0 +1,21 @@
+package option
+
+import (
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/std/clone"
+)
+
+// MoveToHeap returns a deep copy of the value of src allocated on the heap,
+// if src contains a value. Otherwise [typact.None] is returned.
+//
+// It is used to move a value, which has been allocated in an arena
+// (see [typact.Option.CloneInto]), out of the arena before it is freed.
+// If the arena experiment is enabled, the bytes of all strings are copied
+// as well, thus the returned value never references memory of the arena.
+func MoveToHeap[T any](src typact.Option[T]) typact.Option[T] {
+	if src.IsNone() {
+		return src
+	}
+
+	return typact.Some(clone.Deep(src.UnsafeUnwrap()))
+}
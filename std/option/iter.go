@@ -0,0 +1,76 @@
+package option
+
+import (
+	"iter"
+
+	"go.l0nax.org/typact"
+)
+
+// FlattenSeq returns an iterator over the values of all [typact.Some] elements in seq.
+// All [typact.None] elements are skipped.
+//
+// NOTE: It is not called Flatten since [Flatten] removes one level of
+// nesting from an [typact.Option], as Option::flatten does in Rust.
+func FlattenSeq[T any](seq iter.Seq[typact.Option[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for opt := range seq {
+			if opt.IsNone() {
+				continue
+			}
+
+			if !yield(opt.UnsafeUnwrap()) {
+				return
+			}
+		}
+	}
+}
+
+// FilterMap returns an iterator which calls fn on every element of seq
+// and yields the value if fn returned [typact.Some].
+func FilterMap[T any, K any](seq iter.Seq[T], fn func(T) typact.Option[K]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for elem := range seq {
+			opt := fn(elem)
+			if opt.IsNone() {
+				continue
+			}
+
+			if !yield(opt.UnsafeUnwrap()) {
+				return
+			}
+		}
+	}
+}
+
+// FirstSome returns the first [typact.Some] element of seq.
+// If seq does not contain any [typact.Some] element, [typact.None] is returned.
+//
+// The iteration stops as soon as a value has been found.
+func FirstSome[T any](seq iter.Seq[typact.Option[T]]) typact.Option[T] {
+	for opt := range seq {
+		if opt.IsSome() {
+			return opt
+		}
+	}
+
+	return typact.None[T]()
+}
+
+// Collect collects the values of seq into a slice.
+// If any element of seq is [typact.None], [typact.None] is returned
+// and the iteration stops.
+//
+// An empty seq results in [typact.Some] with a nil slice.
+func Collect[T any](seq iter.Seq[typact.Option[T]]) typact.Option[[]T] {
+	var ret []T
+
+	for opt := range seq {
+		if opt.IsNone() {
+			return typact.None[[]T]()
+		}
+
+		ret = append(ret, opt.UnsafeUnwrap())
+	}
+
+	return typact.Some(ret)
+}
@@ -0,0 +1,67 @@
+package option_test
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/std/option"
+)
+
+func ExampleFlattenSeq() {
+	x := []typact.Option[string]{
+		typact.Some("foo"),
+		typact.None[string](),
+		typact.Some("bar"),
+	}
+
+	for val := range option.FlattenSeq(slices.Values(x)) {
+		fmt.Println(val)
+	}
+
+	// Output:
+	// foo
+	// bar
+}
+
+func ExampleFilterMap() {
+	x := []string{"1", "two", "3"}
+	parse := func(s string) typact.Option[int] {
+		return typact.WrapResult(strconv.Atoi(s)).Ok()
+	}
+
+	nums := slices.Collect(option.FilterMap(slices.Values(x), parse))
+	fmt.Println(nums)
+
+	// Output:
+	// [1 3]
+}
+
+func ExampleFirstSome() {
+	x := []typact.Option[int]{
+		typact.None[int](),
+		typact.Some(2),
+		typact.Some(3),
+	}
+
+	fmt.Println(option.FirstSome(slices.Values(x)))
+
+	// Output:
+	// Some(2)
+}
+
+func ExampleCollect() {
+	x := []typact.Option[int]{
+		typact.Some(1),
+		typact.Some(2),
+	}
+	fmt.Println(option.Collect(slices.Values(x)))
+
+	x = append(x, typact.None[int]())
+	fmt.Println(option.Collect(slices.Values(x)))
+
+	// Output:
+	// Some([1 2])
+	// None
+}
@@ -1,7 +1,9 @@
 package option_test
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 
 	"go.l0nax.org/typact"
 	"go.l0nax.org/typact/std/option"
@@ -66,3 +68,95 @@ func ExampleMapOrElse_none() {
 	// Output:
 	// 100
 }
+
+func ExampleAndThen() {
+	parse := func(s string) typact.Option[int] {
+		return typact.WrapResult(strconv.Atoi(s)).Ok()
+	}
+
+	fmt.Println(option.AndThen(typact.Some("42"), parse))
+	fmt.Println(option.AndThen(typact.Some("foo"), parse))
+	fmt.Println(option.AndThen(typact.None[string](), parse))
+
+	// Output:
+	// Some(42)
+	// None
+	// None
+}
+
+func ExampleFlatten() {
+	fmt.Println(option.Flatten(typact.Some(typact.Some(5))))
+	fmt.Println(option.Flatten(typact.Some(typact.None[int]())))
+	fmt.Println(option.Flatten(typact.None[typact.Option[int]]()))
+
+	// Output:
+	// Some(5)
+	// None
+	// None
+}
+
+func ExampleXor() {
+	fmt.Println(option.Xor(typact.Some(2), typact.None[int]()))
+	fmt.Println(option.Xor(typact.None[int](), typact.Some(2)))
+	fmt.Println(option.Xor(typact.Some(2), typact.Some(2)))
+	fmt.Println(option.Xor(typact.None[int](), typact.None[int]()))
+
+	// Output:
+	// Some(2)
+	// Some(2)
+	// None
+	// None
+}
+
+func ExampleOkOr() {
+	errMissing := errors.New("missing value")
+
+	val, err := option.OkOr(typact.Some("foo"), errMissing)
+	fmt.Printf("%q, %v\n", val, err)
+
+	val, err = option.OkOr(typact.None[string](), errMissing)
+	fmt.Printf("%q, %v\n", val, err)
+
+	// Output:
+	// "foo", <nil>
+	// "", missing value
+}
+
+func ExampleZip() {
+	x := typact.Some(1)
+	y := typact.Some("hi")
+	z := typact.None[uint8]()
+
+	fmt.Println(option.Zip(x, y))
+	fmt.Println(option.Zip(x, z))
+
+	// Output:
+	// Some({1 hi})
+	// None
+}
+
+func ExampleZipWith() {
+	x := typact.Some(17.5)
+	y := typact.Some(42.7)
+
+	point := option.ZipWith(x, y, func(x, y float64) string {
+		return fmt.Sprintf("(%.1f, %.1f)", x, y)
+	})
+	fmt.Println(point)
+
+	// Output:
+	// Some((17.5, 42.7))
+}
+
+func ExampleUnzip() {
+	x := typact.Some(option.Pair[int, string]{First: 1, Second: "hi"})
+	a, b := option.Unzip(x)
+	fmt.Println(a, b)
+
+	a, b = option.Unzip(typact.None[option.Pair[int, string]]())
+	fmt.Println(a, b)
+
+	// Output:
+	// Some(1) Some(hi)
+	// None None
+}
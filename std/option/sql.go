@@ -0,0 +1,125 @@
+package option
+
+import (
+	"database/sql"
+	"time"
+
+	"go.l0nax.org/typact"
+)
+
+// FromNull converts n into an [typact.Option].
+// It returns [typact.Some] if n is valid, otherwise [typact.None].
+func FromNull[T any](n sql.Null[T]) typact.Option[T] {
+	if n.Valid {
+		return typact.Some(n.V)
+	}
+
+	return typact.None[T]()
+}
+
+// ToNull converts o into a [sql.Null].
+// The result is valid if o is [typact.Some].
+func ToNull[T any](o typact.Option[T]) sql.Null[T] {
+	val, ok := o.Deconstruct()
+
+	return sql.Null[T]{
+		V:     val,
+		Valid: ok,
+	}
+}
+
+// FromNullString converts n into an [typact.Option].
+func FromNullString(n sql.NullString) typact.Option[string] {
+	return FromNull(sql.Null[string]{V: n.String, Valid: n.Valid})
+}
+
+// ToNullString converts o into a [sql.NullString].
+func ToNullString(o typact.Option[string]) sql.NullString {
+	val, ok := o.Deconstruct()
+
+	return sql.NullString{String: val, Valid: ok}
+}
+
+// FromNullInt64 converts n into an [typact.Option].
+func FromNullInt64(n sql.NullInt64) typact.Option[int64] {
+	return FromNull(sql.Null[int64]{V: n.Int64, Valid: n.Valid})
+}
+
+// ToNullInt64 converts o into a [sql.NullInt64].
+func ToNullInt64(o typact.Option[int64]) sql.NullInt64 {
+	val, ok := o.Deconstruct()
+
+	return sql.NullInt64{Int64: val, Valid: ok}
+}
+
+// FromNullInt32 converts n into an [typact.Option].
+func FromNullInt32(n sql.NullInt32) typact.Option[int32] {
+	return FromNull(sql.Null[int32]{V: n.Int32, Valid: n.Valid})
+}
+
+// ToNullInt32 converts o into a [sql.NullInt32].
+func ToNullInt32(o typact.Option[int32]) sql.NullInt32 {
+	val, ok := o.Deconstruct()
+
+	return sql.NullInt32{Int32: val, Valid: ok}
+}
+
+// FromNullInt16 converts n into an [typact.Option].
+func FromNullInt16(n sql.NullInt16) typact.Option[int16] {
+	return FromNull(sql.Null[int16]{V: n.Int16, Valid: n.Valid})
+}
+
+// ToNullInt16 converts o into a [sql.NullInt16].
+func ToNullInt16(o typact.Option[int16]) sql.NullInt16 {
+	val, ok := o.Deconstruct()
+
+	return sql.NullInt16{Int16: val, Valid: ok}
+}
+
+// FromNullByte converts n into an [typact.Option].
+func FromNullByte(n sql.NullByte) typact.Option[byte] {
+	return FromNull(sql.Null[byte]{V: n.Byte, Valid: n.Valid})
+}
+
+// ToNullByte converts o into a [sql.NullByte].
+func ToNullByte(o typact.Option[byte]) sql.NullByte {
+	val, ok := o.Deconstruct()
+
+	return sql.NullByte{Byte: val, Valid: ok}
+}
+
+// FromNullFloat64 converts n into an [typact.Option].
+func FromNullFloat64(n sql.NullFloat64) typact.Option[float64] {
+	return FromNull(sql.Null[float64]{V: n.Float64, Valid: n.Valid})
+}
+
+// ToNullFloat64 converts o into a [sql.NullFloat64].
+func ToNullFloat64(o typact.Option[float64]) sql.NullFloat64 {
+	val, ok := o.Deconstruct()
+
+	return sql.NullFloat64{Float64: val, Valid: ok}
+}
+
+// FromNullBool converts n into an [typact.Option].
+func FromNullBool(n sql.NullBool) typact.Option[bool] {
+	return FromNull(sql.Null[bool]{V: n.Bool, Valid: n.Valid})
+}
+
+// ToNullBool converts o into a [sql.NullBool].
+func ToNullBool(o typact.Option[bool]) sql.NullBool {
+	val, ok := o.Deconstruct()
+
+	return sql.NullBool{Bool: val, Valid: ok}
+}
+
+// FromNullTime converts n into an [typact.Option].
+func FromNullTime(n sql.NullTime) typact.Option[time.Time] {
+	return FromNull(sql.Null[time.Time]{V: n.Time, Valid: n.Valid})
+}
+
+// ToNullTime converts o into a [sql.NullTime].
+func ToNullTime(o typact.Option[time.Time]) sql.NullTime {
+	val, ok := o.Deconstruct()
+
+	return sql.NullTime{Time: val, Valid: ok}
+}
@@ -0,0 +1,47 @@
+package option
+
+import "go.l0nax.org/typact"
+
+// Pair holds two values of possibly different types.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip zips a with b.
+//
+// If a and b are both [typact.Some], [typact.Some] with a [Pair] of both
+// values is returned. Otherwise [typact.None] is returned.
+func Zip[A any, B any](a typact.Option[A], b typact.Option[B]) typact.Option[Pair[A, B]] {
+	return ZipWith(a, b, func(first A, second B) Pair[A, B] {
+		return Pair[A, B]{
+			First:  first,
+			Second: second,
+		}
+	})
+}
+
+// ZipWith calls fn with the values of a and b, if both are [typact.Some],
+// and returns the result.
+// Otherwise [typact.None] is returned.
+func ZipWith[A any, B any, K any](a typact.Option[A], b typact.Option[B], fn func(A, B) K) typact.Option[K] {
+	if a.IsSome() && b.IsSome() {
+		return typact.Some(fn(a.UnsafeUnwrap(), b.UnsafeUnwrap()))
+	}
+
+	return typact.None[K]()
+}
+
+// Unzip unzips src containing a [Pair] into two options.
+//
+// If src is [typact.Some], both values are returned as [typact.Some].
+// Otherwise two [typact.None] values are returned.
+func Unzip[A any, B any](src typact.Option[Pair[A, B]]) (typact.Option[A], typact.Option[B]) {
+	if src.IsSome() {
+		pair := src.UnsafeUnwrap()
+
+		return typact.Some(pair.First), typact.Some(pair.Second)
+	}
+
+	return typact.None[A](), typact.None[B]()
+}
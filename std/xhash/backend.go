@@ -0,0 +1,100 @@
+package xhash
+
+import (
+	"encoding/binary"
+	"hash"
+	"unsafe"
+)
+
+// New returns a [Hasher] which uses h as the underlying hash algorithm.
+//
+// The values are encoded exactly like with [NewHasher], thus any
+// [hash.Hash] can be used to compute a content-addressed digest of
+// any Go value, e.g. for cache keys:
+//
+//	h := xhash.New(sha256.New())
+//	h.WriteInterface(cfg)
+//	key := h.Sum(nil) // full SHA-256 digest
+//
+// [Hasher.Sum] returns the full digest of h, e.g. 16 bytes for a 128-bit
+// algorithm like [hash/fnv.New128a]. If h does not implement [hash.Hash64],
+// [Hasher.Sum64] returns the first 8 bytes of the digest in big-endian
+// byte order; shorter digests are padded with leading zeros.
+//
+// NOTE: h is reset on [Hasher.Reset] and must not be used afterwards.
+func New(h hash.Hash, opts ...HasherOption) Hasher {
+	if d, ok := h.(digest); ok {
+		return newDefaultHasher(d, opts)
+	}
+
+	return newDefaultHasher(&hashDigest{h: h}, opts)
+}
+
+// hashDigest adapts a [hash.Hash] to the digest interface.
+type hashDigest struct {
+	h   hash.Hash
+	buf [1]byte
+}
+
+// Write adds p to the running hash.
+func (d *hashDigest) Write(p []byte) (int, error) {
+	return d.h.Write(p)
+}
+
+// WriteByte adds b to the running hash.
+func (d *hashDigest) WriteByte(b byte) error {
+	// NOTE: We use a buffer of d since a local array escapes to the heap.
+	d.buf[0] = b
+	_, err := d.h.Write(d.buf[:])
+
+	return err
+}
+
+// WriteString adds the bytes of s to the running hash.
+func (d *hashDigest) WriteString(s string) (int, error) {
+	if sw, ok := d.h.(interface{ WriteString(string) (int, error) }); ok {
+		return sw.WriteString(s)
+	}
+
+	// NOTE: An io.Writer must not retain p, thus we can use the
+	// string data without copying it.
+	return d.h.Write(unsafe.Slice(unsafe.StringData(s), len(s)))
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+func (d *hashDigest) Sum(b []byte) []byte {
+	return d.h.Sum(b)
+}
+
+// Sum64 returns the current hash as uint64.
+func (d *hashDigest) Sum64() uint64 {
+	if h64, ok := d.h.(hash.Hash64); ok {
+		return h64.Sum64()
+	}
+
+	var buf [8]byte
+
+	sum := d.h.Sum(nil)
+	if len(sum) >= len(buf) {
+		return binary.BigEndian.Uint64(sum)
+	}
+
+	copy(buf[len(buf)-len(sum):], sum)
+
+	return binary.BigEndian.Uint64(buf[:])
+}
+
+// Reset resets the hash to its initial state.
+func (d *hashDigest) Reset() {
+	d.h.Reset()
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *hashDigest) Size() int {
+	return d.h.Size()
+}
+
+// BlockSize returns the hash's underlying block size.
+func (d *hashDigest) BlockSize() int {
+	return d.h.BlockSize()
+}
@@ -0,0 +1,32 @@
+package xhash_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/std/xhash"
+)
+
+func ExampleNew() {
+	type Config struct {
+		Endpoint string
+		Retries  typact.Option[int]
+	}
+
+	key := func(cfg Config) string {
+		h := xhash.New(sha256.New())
+		h.WriteInterface(cfg)
+
+		return hex.EncodeToString(h.Sum(nil))
+	}
+
+	a := key(Config{Endpoint: "localhost", Retries: typact.Some(3)})
+	b := key(Config{Endpoint: "localhost", Retries: typact.Some(3)})
+	c := key(Config{Endpoint: "localhost"})
+
+	fmt.Println(len(a), a == b, a == c)
+	// Output:
+	// 64 true false
+}
@@ -0,0 +1,91 @@
+package xhash
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"hash/crc32"
+	"hash/crc64"
+	"hash/fnv"
+	"testing"
+)
+
+// recorder is a [hash.Hash] which records all written bytes.
+type recorder struct {
+	bytes.Buffer
+}
+
+func (r *recorder) Sum(b []byte) []byte { return append(b, r.Bytes()...) }
+func (r *recorder) Size() int           { return r.Len() }
+func (r *recorder) BlockSize() int      { return 1 }
+
+type backendValue struct {
+	Name  string
+	Tags  []string
+	Attrs map[string]int
+	Ratio float64
+}
+
+func TestNewEncodingIsIndependentOfBackend(t *testing.T) {
+	val := backendValue{
+		Name:  "foo",
+		Tags:  []string{"a", "", "b"},
+		Attrs: map[string]int{"x": 1, "y": 2},
+		Ratio: 0.5,
+	}
+
+	rec := &recorder{}
+	New(rec).WriteInterface(val)
+
+	backends := map[string]func() hash.Hash{
+		"sha256":   sha256.New,
+		"fnv64a":   func() hash.Hash { return fnv.New64a() },
+		"fnv128a":  fnv.New128a,
+		"crc32":    func() hash.Hash { return crc32.NewIEEE() },
+		"crc64":    func() hash.Hash { return crc64.New(crc64.MakeTable(crc64.ECMA)) },
+		"xxhash64": func() hash.Hash { return newXXHash64(0) },
+	}
+
+	for name, fn := range backends {
+		t.Run(name, func(t *testing.T) {
+			h := New(fn())
+			h.WriteInterface(val)
+
+			ref := fn()
+			ref.Write(rec.Bytes())
+
+			if got, expected := h.Sum(nil), ref.Sum(nil); !bytes.Equal(got, expected) {
+				t.Errorf("Sum() = %x, expected %x", got, expected)
+			}
+
+			if h.Size() != ref.Size() {
+				t.Errorf("Size() = %d, expected %d", h.Size(), ref.Size())
+			}
+
+			h.Reset()
+			h.WriteInterface(val)
+
+			if got, expected := h.Sum(nil), ref.Sum(nil); !bytes.Equal(got, expected) {
+				t.Errorf("Sum() after Reset = %x, expected %x", got, expected)
+			}
+		})
+	}
+}
+
+func TestNewSum64(t *testing.T) {
+	h := New(sha256.New())
+	_, _ = h.WriteString("foo")
+
+	sum := sha256.Sum256([]byte("foo"))
+	if got, expected := h.Sum64(), binary.BigEndian.Uint64(sum[:]); got != expected {
+		t.Errorf("Sum64() = %#x, expected %#x", got, expected)
+	}
+
+	h = New(crc32.NewIEEE())
+	_, _ = h.WriteString("foo")
+
+	if got, expected := h.Sum64(), uint64(crc32.ChecksumIEEE([]byte("foo"))); got != expected {
+		t.Errorf("Sum64() = %#x, expected %#x", got, expected)
+	}
+}
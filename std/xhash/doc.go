@@ -1,2 +1,16 @@
 // Package xhash provides generic hashing support for all types in Go.
+//
+// [NewHasher] uses a random seed per hasher, thus hashes must not leave
+// the process. For hashes which are persisted, compared across services
+// or used for sharding, use [NewStableHasher] or [NewHasherWithSeed].
+// Their output is guaranteed to be identical across releases and
+// architectures for the same [StableVersion].
+//
+// Any [hash.Hash] can be used with [New], e.g. SHA-256 to compute a
+// content-addressed digest of a value. The encoding of the values is
+// independent of the hash algorithm.
+//
+// NOTE: Pointers, channels and functions are hashed by their address,
+// thus values containing them do not produce stable hashes. Use
+// [WithValueSemantics] to hash pointers by their pointee instead.
 package xhash
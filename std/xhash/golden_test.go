@@ -0,0 +1,216 @@
+package xhash_test
+
+import (
+	"testing"
+
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/std/xhash"
+)
+
+type goldenStruct struct {
+	Name   string
+	Age    int
+	Scores []float64
+	Tags   map[string]bool
+	Nested goldenNested
+}
+
+type goldenNested struct {
+	Enabled bool
+	Ratio   complex128
+}
+
+type goldenFlat struct {
+	A      int8
+	B      uint16
+	C      float32
+	D      complex64
+	E      string
+	hidden int
+	F      [2]goldenNested
+	G      uintptr
+}
+
+type goldenMixed struct {
+	Flat  goldenFlat
+	Items []goldenFlat
+	Ptr   *int
+	Any   any
+	Opt   typact.Option[string]
+}
+
+// goldenHashes holds the expected hashes of [xhash.NewStableHasher].
+//
+// WARN: These values must never change within a [xhash.StableVersion]!
+var goldenHashes = []struct {
+	name     string
+	hash     func(h xhash.Hasher)
+	expected uint64
+}{
+	{"int", func(h xhash.Hasher) { h.WriteInterface(42) }, 0xab285c03271924fd},
+	{"int8", func(h xhash.Hasher) { h.WriteInterface(int8(-7)) }, 0x426656e00d76af66},
+	{"uint64", func(h xhash.Hasher) { h.WriteInterface(uint64(1) << 63) }, 0x24bc0bc65f3dd935},
+	{"float64", func(h xhash.Hasher) { h.WriteInterface(3.14159) }, 0xcd540f7ef61d129f},
+	{"float64 zero", func(h xhash.Hasher) { h.WriteInterface(0.0) }, 0xc86286f146441aa6},
+	{"bool", func(h xhash.Hasher) { h.WriteInterface(true) }, 0xeded2818852844d4},
+	{"string", func(h xhash.Hasher) { h.WriteInterface("hello world") }, 0x496caed76caaef75},
+	{"empty string", func(h xhash.Hasher) { h.WriteInterface("") }, 0x3eccb2e2a81c0c8e},
+	{"slice", func(h xhash.Hasher) { h.WriteInterface([]string{"foo", "", "bar"}) }, 0x24b9f95255b6395e},
+	{"array", func(h xhash.Hasher) { h.WriteInterface([3]int{1, 2, 3}) }, 0x3516203d945f4e28},
+	{"map", func(h xhash.Hasher) { h.WriteInterface(map[string]int{"b": 2, "a": 1, "c": 3}) }, 0x6f18d3cfa0e4b306},
+	{"map int key", func(h xhash.Hasher) { h.WriteInterface(map[int]string{1: "a", 2: "b", 3: "c", 4: "d"}) }, 0x625c6582ba944284},
+	{"map struct key", func(h xhash.Hasher) {
+		h.WriteInterface(map[goldenNested]int{{Enabled: true}: 1, {Ratio: 2}: 2, {}: 3})
+	}, 0xb9364a95833e5ca8},
+	{"struct", func(h xhash.Hasher) {
+		h.WriteInterface(goldenStruct{
+			Name:   "Gopher",
+			Age:    15,
+			Scores: []float64{1.5, -2.25},
+			Tags:   map[string]bool{"x": true, "y": false},
+			Nested: goldenNested{Enabled: true, Ratio: complex(1, -1)},
+		})
+	}, 0xbe4fa04cb193ae21},
+	{"flat struct", func(h xhash.Hasher) {
+		h.WriteInterface(goldenFlat{A: -3, B: 7, C: 1.5, D: complex(2, -2), E: "flat", hidden: 9, G: 11})
+	}, 0xbe06e316eb775c2e},
+	{"flat array", func(h xhash.Hasher) { h.WriteInterface([2]goldenNested{{Enabled: true}, {Ratio: 3}}) }, 0x57160a0d07ba878e},
+	{"scalar slice", func(h xhash.Hasher) { h.WriteInterface([]int32{-1, 0, 1, 1 << 30}) }, 0x06823f0e0258422a},
+	{"float slice", func(h xhash.Hasher) { h.WriteInterface([]float64{0, -0.5, 1e300}) }, 0x89781adcb45ece55},
+	{"mixed struct", func(h xhash.Hasher) {
+		h.WriteInterface(goldenMixed{
+			Flat:  goldenFlat{A: 1, E: "a"},
+			Items: []goldenFlat{{B: 1}, {E: "b", F: [2]goldenNested{{Enabled: true}}}},
+			Any:   goldenFlat{C: 2},
+			Opt:   typact.Some("opt"),
+		})
+	}, 0x3b8c671249233e16},
+	{"mixed struct pointer", func(h xhash.Hasher) {
+		h.WriteInterface([]*goldenMixed{nil})
+	}, 0xacb74b2fbd5fd183},
+	{"Option None", func(h xhash.Hasher) { typact.None[int]().Hash(h) }, 0x9f1ffc793b8a47da},
+	{"Option Some int", func(h xhash.Hasher) { typact.Some(42).Hash(h) }, 0x49ceecce2f20ecda},
+	{"Option Some string", func(h xhash.Hasher) { typact.Some("hello world").Hash(h) }, 0x2075ae028a867594},
+	{"Option Some struct", func(h xhash.Hasher) {
+		typact.Some(goldenNested{Enabled: true, Ratio: complex(0.5, 2)}).Hash(h)
+	}, 0x9d44553081da7adc},
+	{"Option Some nested Option", func(h xhash.Hasher) { typact.Some(typact.Some("foo")).Hash(h) }, 0xd1b856f49a741a4a},
+	{"Write methods", func(h xhash.Hasher) {
+		_ = h.WriteByte('x')
+		_, _ = h.WriteString("foo")
+		h.WriteInt(-1)
+		h.WriteUint64(99)
+		h.WriteFloat64(-0.5)
+	}, 0xca5b5a9a3769f35a},
+}
+
+func intPtr(n int) *int { return &n }
+
+// goldenValueHashes holds the expected hashes of [xhash.NewStableHasher]
+// with [xhash.WithValueSemantics].
+var goldenValueHashes = []struct {
+	name     string
+	val      any
+	expected uint64
+}{
+	{"nil", nil, 0x7c5b4e400f80bf7c},
+	{"pointer", intPtr(42), 0xc7d7c9b5d20f5635},
+	{"nil pointer", (*int)(nil), 0x7839f560e3ab2a60},
+	{"map", map[int][]string{3: {"c"}, 1: {"a", ""}, 2: nil}, 0xd0c4969b1d6dd24f},
+	{"mixed struct", &goldenMixed{
+		Flat:  goldenFlat{A: 1, E: "a"},
+		Items: []goldenFlat{{B: 1}},
+		Ptr:   intPtr(7),
+		Any:   map[string]any{"x": 1.5, "y": []any{"z", nil}},
+		Opt:   typact.Some("opt"),
+	}, 0xd1eddd795810981a},
+}
+
+func TestStableHasherGolden(t *testing.T) {
+	for _, tt := range goldenHashes {
+		t.Run(tt.name, func(t *testing.T) {
+			h := xhash.NewStableHasher()
+			tt.hash(h)
+
+			if got := h.Sum64(); got != tt.expected {
+				t.Errorf("hash = %#016x, expected %#016x", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStableHasher_mapKeys(t *testing.T) {
+	hash := func(val any) uint64 {
+		h := xhash.NewStableHasher()
+		h.WriteInterface(val)
+		return h.Sum64()
+	}
+
+	src := map[int]string{1: "a", 2: "b", 3: "c", 4: "d"}
+	expected := hash(src)
+
+	for range 50 {
+		if got := hash(src); got != expected {
+			t.Fatalf("expected the hash to be independent of the iteration order: %#016x != %#016x", got, expected)
+		}
+	}
+
+	if hash(map[int]string{1: "a"}) == hash(map[int]string{7: "a"}) {
+		t.Error("expected maps with different keys to differ")
+	}
+}
+
+func TestStableHasher_interfaces(t *testing.T) {
+	type holder struct{ X any }
+
+	hash := func(val any) uint64 {
+		h := xhash.NewStableHasher()
+		h.WriteInterface(val)
+		return h.Sum64()
+	}
+
+	if hash(holder{5}) == hash(holder{6}) {
+		t.Error("expected interfaces with different dynamic values to differ")
+	}
+
+	if hash(holder{5}) == hash(holder{int64(5)}) {
+		t.Error("expected interfaces with different dynamic types to differ")
+	}
+
+	// elements of a slice are addressable
+	if hash([]holder{{5}}) != hash([]holder{{5}}) {
+		t.Error("expected addressable interfaces to be hashed by their dynamic value")
+	}
+}
+
+func TestNewHasherWithSeed(t *testing.T) {
+	hash := func(h xhash.Hasher) uint64 {
+		h.WriteInterface(goldenNested{Enabled: true, Ratio: complex(1, 2)})
+		return h.Sum64()
+	}
+
+	if hash(xhash.NewHasherWithSeed(0)) != hash(xhash.NewStableHasher()) {
+		t.Error("expected seed 0 to be identical to NewStableHasher")
+	}
+
+	if hash(xhash.NewHasherWithSeed(1)) != hash(xhash.NewHasherWithSeed(1)) {
+		t.Error("expected hashers with the same seed to be identical")
+	}
+
+	if hash(xhash.NewHasherWithSeed(1)) == hash(xhash.NewHasherWithSeed(2)) {
+		t.Error("expected hashers with different seeds to differ")
+	}
+}
+
+func TestStableHasherGolden_valueSemantics(t *testing.T) {
+	for _, tt := range goldenValueHashes {
+		t.Run(tt.name, func(t *testing.T) {
+			h := xhash.NewStableHasher(xhash.WithValueSemantics())
+			h.WriteInterface(tt.val)
+
+			if got := h.Sum64(); got != tt.expected {
+				t.Errorf("hash = %#016x, expected %#016x", got, tt.expected)
+			}
+		})
+	}
+}
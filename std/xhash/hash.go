@@ -20,13 +20,18 @@ var hashableImpl = reflect.TypeOf((*Hashable)(nil)).Elem()
 // In simple words: if a struct hash two string fields and [Hasher.WriteString] is called
 // successively, the caller must ensure that the resulting hash is unique.
 // I.e., the string tuples ("ab", "c") and ("a", "bc") must result in a different hash.
+//
+// Use the typact-hashgen generator (go.l0nax.org/typact/cmd/typact-hashgen) to
+// generate correct implementations, and the analyzer of the
+// go.l0nax.org/typact/hashlint module to check hand-written ones.
 type Hashable interface {
 	// Hash uses h to hash its value.
 	Hash(h Hasher)
 }
 
 // Hasher is the hashing implementation.
-// For the default implementation use [NewHasher].
+// For the default implementation use [NewHasher], to use a custom
+// hash algorithm use [New].
 type Hasher interface {
 	hash.Hash64
 
@@ -52,5 +57,8 @@ type Hasher interface {
 	//
 	// This method ensures that the hashed data is prefix-free!
 	// If v is a struct, its fields will be hashed recursively – even non-exported ones.
+	//
+	// Pointers and interfaces are hashed including their address,
+	// unless the hasher was created with [WithValueSemantics].
 	WriteInterface(v interface{})
 }
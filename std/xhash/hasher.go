@@ -1,27 +1,85 @@
 package xhash
 
 import (
+	"encoding/binary"
+	"hash"
 	"hash/maphash"
+	"io"
 	"math"
 	"reflect"
 )
 
+// StableVersion is the version of the encoding and algorithm used by
+// [NewStableHasher] and [NewHasherWithSeed].
+//
+// Hashes produced by hashers of the same version are identical across
+// processes, machines and architectures, thus they can be persisted or
+// shared between services. The version is only incremented if the
+// resulting hashes change, which must be treated as a breaking change.
+const StableVersion = 1
+
 // NewHasher returns the default [Hasher] implementation with a random seed.
 //
 // NOTE: Because of [Hash flooding] the seed is generated with each
-// call to this function.
+// call to this function. Thus the resulting hashes differ between
+// processes and must not be persisted, use [NewStableHasher] instead.
 //
 // [Hash flooding]: https://en.wikipedia.org/wiki/Collision_attack#Hash_flooding
-func NewHasher() Hasher {
-	dh := &defaultHasher{}
-	dh.hh.SetSeed(maphash.MakeSeed())
+func NewHasher(opts ...HasherOption) Hasher {
+	hh := &maphash.Hash{}
+	hh.SetSeed(maphash.MakeSeed())
+
+	return newDefaultHasher(hh, opts)
+}
+
+// NewStableHasher returns a deterministic [Hasher] using the xxHash64
+// algorithm with a seed of 0.
+//
+// In contrast to [NewHasher], the resulting hashes are stable across
+// processes and architectures, see [StableVersion].
+//
+// WARN: Do not use this hasher for untrusted input in hash tables, since
+// a fixed seed makes it vulnerable to hash flooding.
+func NewStableHasher(opts ...HasherOption) Hasher {
+	return NewHasherWithSeed(0, opts...)
+}
+
+// NewHasherWithSeed returns a deterministic [Hasher] using the xxHash64
+// algorithm with the given seed.
+//
+// Hashers with the same seed produce the same hashes across processes
+// and architectures, see [StableVersion]. This allows to use a secret
+// seed which is shared between services.
+func NewHasherWithSeed(seed uint64, opts ...HasherOption) Hasher {
+	return newDefaultHasher(newXXHash64(seed), opts)
+}
 
-	return dh
+// digest is the underlying hash algorithm of [defaultHasher].
+type digest interface {
+	hash.Hash64
+	io.ByteWriter
+	io.StringWriter
 }
 
-// defaultHasher is the default [Hasher] implementation using [hash/maphash].
+// defaultHasher is the default [Hasher] implementation.
 type defaultHasher struct {
-	hh maphash.Hash
+	hh  digest
+	cfg config
+	buf [8]byte
+
+	// visiting holds the values which are currently hashed
+	// in the value-semantic mode, to detect cycles.
+	visiting map[visitKey]struct{}
+}
+
+// newDefaultHasher returns a new [defaultHasher] using hh.
+func newDefaultHasher(hh digest, opts []HasherOption) *defaultHasher {
+	d := &defaultHasher{hh: hh}
+	for _, opt := range opts {
+		opt(&d.cfg)
+	}
+
+	return d
 }
 
 // WriteFloat64 implements Hasher.
@@ -41,18 +99,10 @@ func (d *defaultHasher) WriteInt(n int) {
 
 // WriteUint64 implements Hasher.
 func (d *defaultHasher) WriteUint64(n uint64) {
-	data := [8]byte{
-		byte(n >> 56),
-		byte(n >> 48),
-		byte(n >> 40),
-		byte(n >> 32),
-		byte(n >> 24),
-		byte(n >> 16),
-		byte(n >> 8),
-		byte(n),
-	}
+	// NOTE: We use a buffer of d since a local array escapes to the heap.
+	binary.BigEndian.PutUint64(d.buf[:], n)
 
-	_, _ = d.hh.Write(data[:])
+	_, _ = d.hh.Write(d.buf[:])
 }
 
 func (d *defaultHasher) Write(p []byte) (n int, err error) {
@@ -97,57 +147,97 @@ func (d *defaultHasher) WriteString(s string) (int, error) {
 
 // WriteInterface implements Hasher.
 func (d *defaultHasher) WriteInterface(v interface{}) {
-	d.reflectWrite(reflect.ValueOf(v))
+	if v == nil {
+		d.reflectWrite(reflect.Value{})
+		return
+	}
+
+	p := planFor(reflect.TypeOf(v))
+	if p.flat {
+		// fast path: hash directly from memory without reflection
+		d.writeFlat(p, efaceData(&v))
+		return
+	}
+
+	d.writeValue(p, reflect.ValueOf(v))
 }
 
 func (d *defaultHasher) reflectWrite(val reflect.Value) {
+	if !val.IsValid() {
+		// nil interface, e.g. WriteInterface(nil)
+		d.hh.WriteString("<nil>")
+		return
+	}
+
+	d.writeValue(planFor(val.Type()), val)
+}
+
+// writeValue writes val, whose type is described by p.
+func (d *defaultHasher) writeValue(p *typePlan, val reflect.Value) {
+	if p.flat && val.CanAddr() {
+		d.writeFlat(p, val.Addr().UnsafePointer())
+		return
+	}
+
 	// we write the type name first to ensure prefix-freedom
-	// but instead of a (type name) string we use the address since
-	// it will be identical for the same type
-	typ := val.Type()
-	d.hh.WriteString(typ.String())
+	d.hh.WriteString(p.name)
 
-	valKind := val.Kind()
+	if d.cfg.valueSemantics && isNillable(p.kind) {
+		// write whether the value is nil, a back reference or a value
+		if val.IsNil() {
+			d.WriteUint64(0)
+			return
+		}
 
-	if val.IsValid() {
-		if typ.Implements(hashableImpl) {
-			val.Interface().(Hashable).Hash(d)
+		key, ok := d.enter(val)
+		if !ok {
+			d.WriteUint64(2)
 			return
 		}
+		defer d.leave(key)
+
+		d.WriteUint64(1)
 	}
 
-	switch valKind {
+	if p.hashable {
+		val.Interface().(Hashable).Hash(d)
+		return
+	}
+
+	switch p.kind {
 	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Int:
 		d.WriteUint64(uint64(val.Int()))
 
 	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint, reflect.Uintptr:
 		d.WriteUint64(uint64(val.Uint()))
 
-	case reflect.Array, reflect.Slice:
-		for i := range val.Len() {
-			// prevent hashing to the same value
-			// [2]string{"foo", ""} and [2]string{"", "foo"}.
-			d.WriteUint64(uint64(i))
-
-			d.reflectWrite(val.Index(i))
+	case reflect.Slice:
+		if p.elem.flat {
+			// fast path: the elements of a slice are always addressable
+			d.writeFlatElems(p.elem, val.UnsafePointer(), val.Len())
+			return
 		}
 
+		d.writeElems(p.elem, val)
+
+	case reflect.Array:
+		d.writeElems(p.elem, val)
+
 	case reflect.String:
 		d.WriteUint64(uint64(val.Len()))
 		d.WriteString(val.String())
 
 	case reflect.Struct:
-		for i := range typ.NumField() {
+		for i, fld := range p.fields {
 			// ensure prefix-freedom
 			d.WriteUint64(uint64(i))
 
 			// skip all non-exported fields
-			fld := val.Field(i)
-			if !fld.CanInterface() {
+			if !fld.exported {
 				continue
 			}
 
-			d.reflectWrite(fld)
+			d.writeValue(fld.plan, val.Field(i))
 		}
 
 	case reflect.Complex64, reflect.Complex128:
@@ -166,19 +256,16 @@ func (d *defaultHasher) reflectWrite(val reflect.Value) {
 		}
 
 	case reflect.Interface:
-		if !val.CanAddr() {
-			// we cannot hash it. This may be the case if the field is a interface
-			// with value nil.
-			return
-		}
-
-		d.WriteUint64(uint64(val.UnsafeAddr()))
+		// the dynamic value is prefixed with its type name, thus the
+		// address of the interface itself is not required.
+		d.reflectWrite(val.Elem())
 
-		if !val.IsNil() {
-			d.reflectWrite(val.Elem())
+	case reflect.Pointer:
+		if d.cfg.valueSemantics {
+			d.writeValue(p.elem, val.Elem())
+			return
 		}
 
-	case reflect.Pointer:
 		// write the address to ensure prefix-freedom, even if the value is nil
 		d.WriteUint64(uint64(uintptr(val.UnsafePointer())))
 
@@ -186,36 +273,34 @@ func (d *defaultHasher) reflectWrite(val reflect.Value) {
 			return
 		}
 
-		d.reflectWrite(val.Elem())
-
-	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
-		d.WriteUint64(uint64(uintptr(val.UnsafePointer())))
-
-	case reflect.Map:
-		mapLen := val.Len()
-		keys := getMapKeys(mapLen)
-
-		for i, iter := 0, val.MapRange(); i < mapLen && iter.Next(); i++ {
-			keyVal := iter.Key()
+		d.writeValue(p.elem, val.Elem())
 
-			(*keys)[i] = mapKeyEntry{
-				key: keyVal.String(),
-				val: keyVal,
-			}
+	case reflect.Func:
+		if d.cfg.valueSemantics {
+			// non-nil functions are never deeply equal
+			return
 		}
 
-		keys.Sort()
-
-		for i, key := range *keys {
-			// to be prefix-free, we write the key index
-			d.WriteUint64(uint64(i))
+		d.WriteUint64(uint64(uintptr(val.UnsafePointer())))
 
-			d.hh.WriteString(key.key)
+	case reflect.Chan, reflect.UnsafePointer:
+		d.WriteUint64(uint64(uintptr(val.UnsafePointer())))
 
-			d.reflectWrite(val.MapIndex(key.val))
-		}
+	case reflect.Map:
+		d.writeMapEntries(val)
 
 	default:
-		panic("xhash.defaultHasher: type " + val.Type().String() + " not supported")
+		panic("xhash.defaultHasher: type " + p.name + " not supported")
+	}
+}
+
+// writeElems writes the elements of the array or slice val.
+func (d *defaultHasher) writeElems(elem *typePlan, val reflect.Value) {
+	for i := range val.Len() {
+		// prevent hashing to the same value
+		// [2]string{"foo", ""} and [2]string{"", "foo"}.
+		d.WriteUint64(uint64(i))
+
+		d.writeValue(elem, val.Index(i))
 	}
 }
@@ -0,0 +1,54 @@
+package xhash
+
+import "testing"
+
+type benchFlat struct {
+	ID     int64
+	Name   string
+	Score  float64
+	Active bool
+	Tags   [4]uint16
+}
+
+type benchNested struct {
+	Flat  benchFlat
+	Items []benchFlat
+	Ptr   *benchFlat
+	Ints  []int
+}
+
+func BenchmarkWriteInterface(b *testing.B) {
+	flat := benchFlat{ID: 1, Name: "gopher", Score: 1.5, Active: true, Tags: [4]uint16{1, 2, 3, 4}}
+
+	ints := make([]int, 64)
+	for i := range ints {
+		ints[i] = i
+	}
+
+	cases := map[string]any{
+		"case=int":    42,
+		"case=string": "hello world",
+		"case=flat":   flat,
+		"case=ints":   ints,
+		"case=nested": benchNested{
+			Flat:  flat,
+			Items: []benchFlat{flat, flat, flat},
+			Ptr:   &flat,
+			Ints:  ints,
+		},
+	}
+
+	for name, val := range cases {
+		b.Run(name, func(b *testing.B) {
+			h := NewHasher()
+
+			b.ReportAllocs()
+
+			for range b.N {
+				h.WriteInterface(val)
+				_ = h.Sum64()
+				h.Reset()
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package xhash
+
+import (
+	"bytes"
+	"reflect"
+	"slices"
+)
+
+// mapEntry holds the encoded key and value of a map entry.
+type mapEntry struct {
+	key []byte
+	val []byte
+}
+
+// writeMapEntries writes the entries of the map val sorted by their
+// encoded key and value, thus the result is independent of the iteration order.
+func (d *defaultHasher) writeMapEntries(val reflect.Value) {
+	buf := &bufferDigest{}
+	enc := &defaultHasher{
+		hh:       buf,
+		cfg:      d.cfg,
+		visiting: d.visiting,
+	}
+
+	entries := make([]mapEntry, 0, val.Len())
+
+	for iter := val.MapRange(); iter.Next(); {
+		enc.reflectWrite(iter.Key())
+		key := bytes.Clone(buf.Bytes())
+		buf.Reset()
+
+		enc.reflectWrite(iter.Value())
+		entries = append(entries, mapEntry{
+			key: key,
+			val: bytes.Clone(buf.Bytes()),
+		})
+		buf.Reset()
+	}
+
+	slices.SortFunc(entries, func(a, b mapEntry) int {
+		if c := bytes.Compare(a.key, b.key); c != 0 {
+			return c
+		}
+
+		return bytes.Compare(a.val, b.val)
+	})
+
+	d.WriteUint64(uint64(len(entries)))
+
+	for i, entry := range entries {
+		// to be prefix-free, we write the index and lengths
+		d.WriteUint64(uint64(i))
+		d.WriteUint64(uint64(len(entry.key)))
+		_, _ = d.hh.Write(entry.key)
+		d.WriteUint64(uint64(len(entry.val)))
+		_, _ = d.hh.Write(entry.val)
+	}
+}
+
+// bufferDigest is a digest which records the written data,
+// used to encode map entries.
+type bufferDigest struct {
+	bytes.Buffer
+}
+
+// Sum appends the written data to b.
+func (b *bufferDigest) Sum(p []byte) []byte {
+	return append(p, b.Bytes()...)
+}
+
+// Sum64 is not supported and always returns 0.
+func (b *bufferDigest) Sum64() uint64 {
+	return 0
+}
+
+// Size returns the number of written bytes.
+func (b *bufferDigest) Size() int {
+	return b.Len()
+}
+
+// BlockSize returns 1.
+func (b *bufferDigest) BlockSize() int {
+	return 1
+}
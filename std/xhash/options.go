@@ -0,0 +1,32 @@
+package xhash
+
+// HasherOption configures the behavior of a [Hasher].
+type HasherOption func(*config)
+
+type config struct {
+	valueSemantics bool
+}
+
+// WithValueSemantics enables the value-semantic mode of [Hasher.WriteInterface].
+//
+// By default pointers are hashed including their address, thus two equal values behind different pointers hash differently.
+// In the value-semantic mode the following rules apply instead:
+//
+//   - Pointers are hashed by their pointee only. Cyclic values are supported,
+//     a pointer which is already being hashed is written as a back reference.
+//   - nil pointers, maps and slices are hashed as nil, thus they differ
+//     from non-nil empty values.
+//   - Functions are hashed by whether they are nil.
+//
+// As a result, the hashes of a and b are equal whenever
+// [reflect.DeepEqual](a, b) reports true, as long as all [Hashable]
+// implementations respect this, too. The only exception are cyclic values
+// whose cycles have a different length but unfold into the same infinite value.
+//
+// NOTE: Channels and unsafe.Pointer are still hashed by their address,
+// since they are only deeply equal if they are identical.
+func WithValueSemantics() HasherOption {
+	return func(c *config) {
+		c.valueSemantics = true
+	}
+}
@@ -0,0 +1,215 @@
+package xhash
+
+import (
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// plans caches the [typePlan] of each [reflect.Type].
+var plans sync.Map // map[reflect.Type]*typePlan
+
+// typePlan holds the precomputed information required to hash a value of a type.
+type typePlan struct {
+	typ  reflect.Type
+	name string
+	kind reflect.Kind
+
+	// hashable reports whether the type implements [Hashable].
+	hashable bool
+
+	// flat reports whether values of the type can be hashed directly from
+	// memory, i.e. the type consists only of scalars and strings.
+	// Flat types never contain pointers.
+	flat bool
+
+	size uintptr
+
+	// elem is the plan of the element type of arrays, slices, pointers and maps.
+	elem *typePlan
+
+	// len is the length of an array.
+	len int
+
+	fields []fieldPlan
+}
+
+// fieldPlan holds the precomputed information of a struct field.
+type fieldPlan struct {
+	plan     *typePlan
+	offset   uintptr
+	exported bool
+}
+
+// planFor returns the cached [typePlan] of typ.
+func planFor(typ reflect.Type) *typePlan {
+	if p, ok := plans.Load(typ); ok {
+		return p.(*typePlan)
+	}
+
+	building := make(map[reflect.Type]*typePlan)
+	p := buildPlan(typ, building)
+
+	// NOTE: Plans are only stored once they are complete, i.e. after the
+	// outermost call, since recursive types reference incomplete plans.
+	for t, bp := range building {
+		if t != typ {
+			plans.LoadOrStore(t, bp)
+		}
+	}
+
+	actual, _ := plans.LoadOrStore(typ, p)
+
+	return actual.(*typePlan)
+}
+
+// buildPlan builds the [typePlan] of typ. building holds the plans which are
+// currently built, to support recursive types.
+func buildPlan(typ reflect.Type, building map[reflect.Type]*typePlan) *typePlan {
+	if p, ok := plans.Load(typ); ok {
+		return p.(*typePlan)
+	}
+
+	if p, ok := building[typ]; ok {
+		return p
+	}
+
+	p := &typePlan{
+		typ:      typ,
+		name:     typ.String(),
+		kind:     typ.Kind(),
+		hashable: typ.Implements(hashableImpl),
+		size:     typ.Size(),
+	}
+	building[typ] = p
+
+	switch p.kind {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+		reflect.String:
+		p.flat = !p.hashable
+
+	case reflect.Array:
+		p.elem = buildPlan(typ.Elem(), building)
+		p.len = typ.Len()
+		p.flat = !p.hashable && p.elem.flat
+
+	case reflect.Slice, reflect.Pointer, reflect.Map:
+		p.elem = buildPlan(typ.Elem(), building)
+
+	case reflect.Struct:
+		p.fields = make([]fieldPlan, typ.NumField())
+		p.flat = !p.hashable
+
+		for i := range p.fields {
+			fld := typ.Field(i)
+
+			p.fields[i] = fieldPlan{
+				offset:   fld.Offset,
+				exported: fld.IsExported(),
+			}
+
+			// NOTE: non-exported fields are not hashed
+			if !fld.IsExported() {
+				continue
+			}
+
+			p.fields[i].plan = buildPlan(fld.Type, building)
+			p.flat = p.flat && p.fields[i].plan.flat
+		}
+	}
+
+	return p
+}
+
+// writeFlat writes the value of the flat type p located at ptr.
+func (d *defaultHasher) writeFlat(p *typePlan, ptr unsafe.Pointer) {
+	d.hh.WriteString(p.name)
+
+	switch p.kind {
+	case reflect.Int:
+		d.WriteUint64(uint64(*(*int)(ptr)))
+	case reflect.Int8:
+		d.WriteUint64(uint64(*(*int8)(ptr)))
+	case reflect.Int16:
+		d.WriteUint64(uint64(*(*int16)(ptr)))
+	case reflect.Int32:
+		d.WriteUint64(uint64(*(*int32)(ptr)))
+	case reflect.Int64:
+		d.WriteUint64(uint64(*(*int64)(ptr)))
+
+	case reflect.Uint:
+		d.WriteUint64(uint64(*(*uint)(ptr)))
+	case reflect.Uint8:
+		d.WriteUint64(uint64(*(*uint8)(ptr)))
+	case reflect.Uint16:
+		d.WriteUint64(uint64(*(*uint16)(ptr)))
+	case reflect.Uint32:
+		d.WriteUint64(uint64(*(*uint32)(ptr)))
+	case reflect.Uint64:
+		d.WriteUint64(*(*uint64)(ptr))
+	case reflect.Uintptr:
+		d.WriteUint64(uint64(*(*uintptr)(ptr)))
+
+	case reflect.Float32:
+		d.WriteFloat64(float64(*(*float32)(ptr)))
+	case reflect.Float64:
+		d.WriteFloat64(*(*float64)(ptr))
+
+	case reflect.Complex64:
+		c := *(*complex64)(ptr)
+		d.WriteFloat64(float64(real(c)))
+		d.WriteFloat64(float64(imag(c)))
+	case reflect.Complex128:
+		c := *(*complex128)(ptr)
+		d.WriteFloat64(real(c))
+		d.WriteFloat64(imag(c))
+
+	case reflect.Bool:
+		if *(*bool)(ptr) {
+			d.WriteUint64(1)
+		} else {
+			d.WriteUint64(0)
+		}
+
+	case reflect.String:
+		s := *(*string)(ptr)
+		d.WriteUint64(uint64(len(s)))
+		d.hh.WriteString(s)
+
+	case reflect.Array:
+		d.writeFlatElems(p.elem, ptr, p.len)
+
+	case reflect.Struct:
+		for i, fld := range p.fields {
+			// ensure prefix-freedom
+			d.WriteUint64(uint64(i))
+
+			if fld.exported {
+				d.writeFlat(fld.plan, unsafe.Add(ptr, fld.offset))
+			}
+		}
+	}
+}
+
+// writeFlatElems writes n consecutive elements of the flat type elem located at ptr.
+func (d *defaultHasher) writeFlatElems(elem *typePlan, ptr unsafe.Pointer, n int) {
+	for i := range n {
+		// prevent hashing to the same value
+		// [2]string{"foo", ""} and [2]string{"", "foo"}.
+		d.WriteUint64(uint64(i))
+
+		d.writeFlat(elem, unsafe.Add(ptr, uintptr(i)*elem.size))
+	}
+}
+
+// efaceData returns the pointer to the value of the non-nil interface v.
+//
+// WARN: This is only valid if the value is not stored directly in the
+// interface, which is the case for all flat types since they do not
+// contain pointers.
+func efaceData(v *any) unsafe.Pointer {
+	return (*[2]unsafe.Pointer)(unsafe.Pointer(v))[1]
+}
@@ -0,0 +1,92 @@
+package xhash
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+type planRecursive struct {
+	Val      int
+	Next     *planRecursive
+	Children []planRecursive
+	Lookup   map[string]*planRecursive
+}
+
+func TestPlanFor(t *testing.T) {
+	p := planFor(reflect.TypeFor[planRecursive]())
+
+	if p != planFor(reflect.TypeFor[planRecursive]()) {
+		t.Error("expected plan to be cached")
+	}
+
+	if p.flat {
+		t.Error("expected recursive type not to be flat")
+	}
+
+	if p.fields[1].plan.elem != p || p.fields[2].plan.elem != p || p.fields[3].plan.elem.elem != p {
+		t.Error("expected recursive plans to reference the same plan")
+	}
+
+	if !planFor(reflect.TypeFor[benchFlat]()).flat {
+		t.Error("expected benchFlat to be flat")
+	}
+
+	if planFor(reflect.TypeFor[[2]*int]()).flat {
+		t.Error("expected array of pointers not to be flat")
+	}
+}
+
+func TestPlanFor_concurrent(t *testing.T) {
+	type concurrent struct {
+		A planRecursive
+		B []benchFlat
+	}
+
+	var wg sync.WaitGroup
+
+	hashes := make([]uint64, 16)
+	for i := range hashes {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			h := NewStableHasher()
+			h.WriteInterface(concurrent{A: planRecursive{Val: 1}, B: []benchFlat{{ID: 1}}})
+			hashes[i] = h.Sum64()
+		}()
+	}
+
+	wg.Wait()
+
+	for _, hash := range hashes[1:] {
+		if hash != hashes[0] {
+			t.Fatalf("expected equal hashes, got %#x and %#x", hash, hashes[0])
+		}
+	}
+}
+
+func TestWriteInterface_allocs(t *testing.T) {
+	flat := benchFlat{ID: 1, Name: "gopher", Tags: [4]uint16{1, 2}}
+	vals := []any{
+		42,
+		"foo",
+		flat,
+		[]benchFlat{flat, flat},
+		[]int{1, 2, 3},
+		benchNested{Flat: flat, Items: []benchFlat{flat}, Ptr: &flat, Ints: []int{1}},
+	}
+
+	h := NewStableHasher()
+	for _, val := range vals {
+		allocs := testing.AllocsPerRun(100, func() {
+			h.WriteInterface(val)
+			h.Reset()
+		})
+
+		if allocs != 0 {
+			t.Errorf("WriteInterface(%T) allocated %v times, expected 0", val, allocs)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package xhash
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// visitKey identifies a value which is currently hashed
+// in the value-semantic mode.
+type visitKey struct {
+	ptr unsafe.Pointer
+	len int
+	typ reflect.Type
+}
+
+// isNillable reports whether values of kind can be nil.
+func isNillable(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface, reflect.Func, reflect.Chan:
+		return true
+	}
+
+	return false
+}
+
+// enter marks the non-nil val as being hashed. It returns false
+// if val is already being hashed, i.e. val is part of a cycle.
+//
+// Only pointers, maps and slices are tracked, since every cycle
+// contains at least one of them.
+func (d *defaultHasher) enter(val reflect.Value) (*visitKey, bool) {
+	switch val.Kind() {
+	case reflect.Pointer, reflect.Map:
+	case reflect.Slice:
+		if val.Len() == 0 {
+			// empty slices cannot be part of a cycle
+			return nil, true
+		}
+	default:
+		return nil, true
+	}
+
+	key := visitKey{
+		ptr: val.UnsafePointer(),
+		typ: val.Type(),
+	}
+
+	if val.Kind() == reflect.Slice {
+		key.len = val.Len()
+	}
+
+	if _, ok := d.visiting[key]; ok {
+		return nil, false
+	}
+
+	if d.visiting == nil {
+		d.visiting = make(map[visitKey]struct{})
+	}
+
+	d.visiting[key] = struct{}{}
+
+	return &key, true
+}
+
+// leave removes key, as returned by enter, from the values being hashed.
+func (d *defaultHasher) leave(key *visitKey) {
+	if key != nil {
+		delete(d.visiting, *key)
+	}
+}
@@ -0,0 +1,206 @@
+package xhash
+
+import (
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"go.l0nax.org/typact/std/clone"
+)
+
+type propNested struct {
+	Val   int
+	Label *string
+	Attrs map[string]float64
+}
+
+type propValue struct {
+	Name   string
+	Ptr    *int
+	PtrPtr **string
+	Nested *propNested
+	Items  []*propNested
+	Map    map[string]*int
+	IntMap map[int]string
+	Keys   map[[2]int8]bool
+	Floats []float64
+}
+
+// propHolder holds a [propValue] behind an interface,
+// since quick cannot generate interfaces.
+type propHolder struct {
+	Val propValue
+	Any any
+}
+
+func valueHash(v any) uint64 {
+	h := NewStableHasher(WithValueSemantics())
+	h.WriteInterface(v)
+
+	return h.Sum64()
+}
+
+func TestValueSemantics_DeepEqualClone(t *testing.T) {
+	fn := func(val propValue) bool {
+		v := propHolder{Val: val, Any: val.Nested}
+
+		cloned := clone.Deep(v)
+		if !reflect.DeepEqual(v, cloned) {
+			t.Fatalf("clone is not deeply equal: %+v", v)
+		}
+
+		return valueHash(v) == valueHash(cloned) && valueHash(&v) == valueHash(&cloned)
+	}
+
+	if err := quick.Check(fn, &quick.Config{MaxCount: 500}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestValueSemantics_DeepEqualIff(t *testing.T) {
+	fn := func(a, b propValue) bool {
+		return reflect.DeepEqual(a, b) == (valueHash(a) == valueHash(b))
+	}
+
+	if err := quick.Check(fn, nil); err != nil {
+		t.Error(err)
+	}
+
+	same := func(a propValue) bool {
+		b := a
+		return valueHash(a) == valueHash(b)
+	}
+
+	if err := quick.Check(same, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestValueSemantics_MapOrder(t *testing.T) {
+	m := make(map[int]string)
+	for i := range 100 {
+		m[i] = string(rune('a' + i%26))
+	}
+
+	expected := valueHash(m)
+
+	for range 20 {
+		if got := valueHash(clone.Deep(m)); got != expected {
+			t.Fatalf("hash = %#x, expected %#x", got, expected)
+		}
+	}
+
+	m[0] = "z"
+	if valueHash(m) == expected {
+		t.Error("expected different hash for different map values")
+	}
+}
+
+type ringNode struct {
+	Val  int
+	Next *ringNode
+}
+
+func newRing(vals ...int) *ringNode {
+	head := &ringNode{Val: vals[0]}
+
+	cur := head
+	for _, v := range vals[1:] {
+		cur.Next = &ringNode{Val: v}
+		cur = cur.Next
+	}
+
+	cur.Next = head
+
+	return head
+}
+
+func TestValueSemantics_Cycles(t *testing.T) {
+	a, b := newRing(1, 2, 3), newRing(1, 2, 3)
+	if valueHash(a) != valueHash(b) {
+		t.Error("expected equal rings to have equal hashes")
+	}
+
+	if valueHash(a) == valueHash(newRing(1, 2, 4)) {
+		t.Error("expected different rings to have different hashes")
+	}
+
+	s := make([]any, 2)
+	s[0] = 1
+	s[1] = s
+
+	m := map[string]any{"foo": 1}
+	m["self"] = m
+
+	// must not overflow the stack
+	_ = valueHash(s)
+	_ = valueHash(m)
+
+	// shared, non-cyclic pointers are hashed every time
+	shared := &propNested{Val: 1}
+	copied := &propNested{Val: 1}
+
+	if valueHash([]*propNested{shared, shared}) != valueHash([]*propNested{shared, copied}) {
+		t.Error("expected shared pointers to be hashed by value")
+	}
+}
+
+type ifaceStruct struct {
+	A any
+	F func()
+}
+
+func TestValueSemantics_Interfaces(t *testing.T) {
+	hashVal := func(val reflect.Value) uint64 {
+		h := newDefaultHasher(newXXHash64(0), []HasherOption{WithValueSemantics()})
+		h.reflectWrite(val)
+
+		return h.Sum64()
+	}
+
+	for _, v := range []ifaceStruct{{}, {A: 1}, {A: &propNested{Val: 1}}, {A: []string{"foo"}}} {
+		// the fields of v are not addressable, the fields of ptr are
+		ptr := &v
+		if hashVal(reflect.ValueOf(v)) != hashVal(reflect.ValueOf(ptr).Elem()) {
+			t.Errorf("expected addressable and non-addressable %+v to have equal hashes", v)
+		}
+	}
+
+	hashes := map[uint64]any{}
+	for _, v := range []any{
+		ifaceStruct{},
+		ifaceStruct{A: 1},
+		ifaceStruct{A: int64(1)},
+		ifaceStruct{A: (*int)(nil)},
+		ifaceStruct{F: func() {}},
+	} {
+		hash := valueHash(v)
+		if prev, ok := hashes[hash]; ok {
+			t.Errorf("expected %#v and %#v to have different hashes", prev, v)
+		}
+
+		hashes[hash] = v
+	}
+
+	// must not panic
+	_ = valueHash(nil)
+
+	if valueHash(nil) == valueHash((*int)(nil)) {
+		t.Error("expected nil interface and nil pointer to have different hashes")
+	}
+}
+
+func TestValueSemantics_Pointers(t *testing.T) {
+	x, y := 42, 42
+	if valueHash(&x) != valueHash(&y) {
+		t.Error("expected pointers to equal values to have equal hashes")
+	}
+
+	h1, h2 := NewStableHasher(), NewStableHasher()
+	h1.WriteInterface(&x)
+	h2.WriteInterface(&y)
+
+	if h1.Sum64() == h2.Sum64() {
+		t.Error("expected default mode to hash the address")
+	}
+}
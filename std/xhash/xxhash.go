@@ -0,0 +1,175 @@
+package xhash
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"unsafe"
+)
+
+// The primes of the xxHash64 algorithm.
+const (
+	xxPrime1 uint64 = 11400714785074694791
+	xxPrime2 uint64 = 14029467366897019727
+	xxPrime3 uint64 = 1609587929392839161
+	xxPrime4 uint64 = 9650029242287828579
+	xxPrime5 uint64 = 2870177450012600261
+)
+
+// xxBlockSize is the size of a stripe processed at once.
+const xxBlockSize = 32
+
+// xxHash64 implements the xxHash64 algorithm as specified in
+// https://github.com/Cyan4973/xxHash/blob/dev/doc/xxhash_spec.md.
+//
+// It is implemented in-tree to keep typact free of dependencies.
+type xxHash64 struct {
+	seed  uint64
+	v1    uint64
+	v2    uint64
+	v3    uint64
+	v4    uint64
+	total uint64
+	mem   [xxBlockSize]byte
+	n     int // number of bytes in mem
+}
+
+// newXXHash64 returns a new xxHash64 digest with the given seed.
+func newXXHash64(seed uint64) *xxHash64 {
+	x := &xxHash64{seed: seed}
+	x.Reset()
+
+	return x
+}
+
+// Reset resets the digest to its initial state.
+func (x *xxHash64) Reset() {
+	x.v1 = x.seed + xxPrime1 + xxPrime2
+	x.v2 = x.seed + xxPrime2
+	x.v3 = x.seed
+	x.v4 = x.seed - xxPrime1
+	x.total = 0
+	x.n = 0
+}
+
+// Size returns the number of bytes Sum will return.
+func (x *xxHash64) Size() int {
+	return 8
+}
+
+// BlockSize returns the hash's underlying block size.
+func (x *xxHash64) BlockSize() int {
+	return xxBlockSize
+}
+
+// Write adds p to the running hash. It never returns an error.
+func (x *xxHash64) Write(p []byte) (int, error) {
+	n := len(p)
+	x.total += uint64(n)
+
+	if x.n+n < xxBlockSize {
+		// not enough data for a full stripe
+		x.n += copy(x.mem[x.n:], p)
+		return n, nil
+	}
+
+	if x.n > 0 {
+		// fill the buffered stripe first
+		c := copy(x.mem[x.n:], p)
+		x.v1 = xxRound(x.v1, binary.LittleEndian.Uint64(x.mem[0:8]))
+		x.v2 = xxRound(x.v2, binary.LittleEndian.Uint64(x.mem[8:16]))
+		x.v3 = xxRound(x.v3, binary.LittleEndian.Uint64(x.mem[16:24]))
+		x.v4 = xxRound(x.v4, binary.LittleEndian.Uint64(x.mem[24:32]))
+		p = p[c:]
+		x.n = 0
+	}
+
+	for len(p) >= xxBlockSize {
+		x.v1 = xxRound(x.v1, binary.LittleEndian.Uint64(p[0:8]))
+		x.v2 = xxRound(x.v2, binary.LittleEndian.Uint64(p[8:16]))
+		x.v3 = xxRound(x.v3, binary.LittleEndian.Uint64(p[16:24]))
+		x.v4 = xxRound(x.v4, binary.LittleEndian.Uint64(p[24:32]))
+		p = p[xxBlockSize:]
+	}
+
+	x.n = copy(x.mem[:], p)
+
+	return n, nil
+}
+
+// WriteByte adds b to the running hash. It never returns an error.
+func (x *xxHash64) WriteByte(b byte) error {
+	data := [1]byte{b}
+	_, _ = x.Write(data[:])
+
+	return nil
+}
+
+// WriteString adds the bytes of s to the running hash. It never returns an error.
+func (x *xxHash64) WriteString(s string) (int, error) {
+	// NOTE: Write does not retain p, thus we can use the
+	// string data without copying it.
+	return x.Write(unsafe.Slice(unsafe.StringData(s), len(s)))
+}
+
+// Sum appends the current hash to b in big-endian byte order
+// and returns the resulting slice.
+func (x *xxHash64) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint64(b, x.Sum64())
+}
+
+// Sum64 returns the current hash. It does not change the underlying hash state.
+func (x *xxHash64) Sum64() uint64 {
+	var h uint64
+
+	if x.total >= xxBlockSize {
+		h = bits.RotateLeft64(x.v1, 1) + bits.RotateLeft64(x.v2, 7) +
+			bits.RotateLeft64(x.v3, 12) + bits.RotateLeft64(x.v4, 18)
+		h = xxMergeRound(h, x.v1)
+		h = xxMergeRound(h, x.v2)
+		h = xxMergeRound(h, x.v3)
+		h = xxMergeRound(h, x.v4)
+	} else {
+		h = x.seed + xxPrime5
+	}
+
+	h += x.total
+
+	p := x.mem[:x.n]
+	for ; len(p) >= 8; p = p[8:] {
+		h ^= xxRound(0, binary.LittleEndian.Uint64(p))
+		h = bits.RotateLeft64(h, 27)*xxPrime1 + xxPrime4
+	}
+
+	if len(p) >= 4 {
+		h ^= uint64(binary.LittleEndian.Uint32(p)) * xxPrime1
+		h = bits.RotateLeft64(h, 23)*xxPrime2 + xxPrime3
+		p = p[4:]
+	}
+
+	for _, b := range p {
+		h ^= uint64(b) * xxPrime5
+		h = bits.RotateLeft64(h, 11) * xxPrime1
+	}
+
+	h ^= h >> 33
+	h *= xxPrime2
+	h ^= h >> 29
+	h *= xxPrime3
+	h ^= h >> 32
+
+	return h
+}
+
+func xxRound(acc, input uint64) uint64 {
+	acc += input * xxPrime2
+	acc = bits.RotateLeft64(acc, 31)
+
+	return acc * xxPrime1
+}
+
+func xxMergeRound(acc, val uint64) uint64 {
+	val = xxRound(0, val)
+	acc ^= val
+
+	return acc*xxPrime1 + xxPrime4
+}
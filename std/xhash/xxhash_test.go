@@ -0,0 +1,42 @@
+package xhash
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestXXHash64(t *testing.T) {
+	// reference values of the xxHash64 specification
+	tests := []struct {
+		input    string
+		seed     uint64
+		expected uint64
+	}{
+		{"", 0, 0xef46db3751d8e999},
+		{"a", 0, 0xd24ec4f1a98c6e5b},
+		{"abc", 0, 0x44bc2cf5ad770999},
+		{"message digest", 0, 0x066ed728fceeb3be},
+		{"abcdefghijklmnopqrstuvwxyz", 0, 0xcfe1f278fa89835c},
+		{"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789", 0, 0xaaa46907d3047814},
+		{strings.Repeat("1234567890", 8), 0, 0xe04a477f19ee145d},
+	}
+
+	for _, tt := range tests {
+		x := newXXHash64(tt.seed)
+		_, _ = x.WriteString(tt.input)
+
+		if got := x.Sum64(); got != tt.expected {
+			t.Errorf("xxHash64(%q) = %#x, expected %#x", tt.input, got, tt.expected)
+		}
+
+		// write byte-wise to exercise the buffering
+		x.Reset()
+		for i := range len(tt.input) {
+			_ = x.WriteByte(tt.input[i])
+		}
+
+		if got := x.Sum64(); got != tt.expected {
+			t.Errorf("xxHash64(%q) written byte-wise = %#x, expected %#x", tt.input, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,2 @@
+// Package xslog provides [log/slog] helpers for [typact.Option] values.
+package xslog
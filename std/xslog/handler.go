@@ -0,0 +1,93 @@
+package xslog
+
+import (
+	"context"
+	"log/slog"
+)
+
+// noneLike is implemented by [typact.Option].
+type noneLike interface {
+	IsNone() bool
+}
+
+// handler drops all attributes with a [typact.None] value.
+type handler struct {
+	next slog.Handler
+}
+
+// NewHandler returns a [slog.Handler] which drops all attributes whose
+// value is [typact.None], including attributes in groups, before passing
+// the record to next.
+//
+// In contrast to [typact.NoneLogOmit], the attributes are dropped
+// independent of the [typact.NoneLogPolicy] and of next.
+func NewHandler(next slog.Handler) slog.Handler {
+	return &handler{
+		next: next,
+	}
+}
+
+// Enabled implements the [slog.Handler] interface.
+func (h *handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle implements the [slog.Handler] interface.
+func (h *handler) Handle(ctx context.Context, r slog.Record) error {
+	ret := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+
+	r.Attrs(func(attr slog.Attr) bool {
+		if attr, ok := filterAttr(attr); ok {
+			ret.AddAttrs(attr)
+		}
+
+		return true
+	})
+
+	return h.next.Handle(ctx, ret)
+}
+
+// WithAttrs implements the [slog.Handler] interface.
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &handler{
+		next: h.next.WithAttrs(filterAttrs(attrs)),
+	}
+}
+
+// WithGroup implements the [slog.Handler] interface.
+func (h *handler) WithGroup(name string) slog.Handler {
+	return &handler{
+		next: h.next.WithGroup(name),
+	}
+}
+
+// filterAttrs returns attrs without the attributes with a [typact.None] value.
+func filterAttrs(attrs []slog.Attr) []slog.Attr {
+	ret := make([]slog.Attr, 0, len(attrs))
+
+	for _, attr := range attrs {
+		if attr, ok := filterAttr(attr); ok {
+			ret = append(ret, attr)
+		}
+	}
+
+	return ret
+}
+
+// filterAttr returns attr with all [typact.None] values removed from groups.
+// It returns false if the value of attr is [typact.None].
+func filterAttr(attr slog.Attr) (slog.Attr, bool) {
+	switch attr.Value.Kind() {
+	case slog.KindLogValuer:
+		if opt, ok := attr.Value.Any().(noneLike); ok && opt.IsNone() {
+			return attr, false
+		}
+
+	case slog.KindGroup:
+		attr.Value = slog.GroupValue(filterAttrs(attr.Value.Group())...)
+	}
+
+	return attr, true
+}
+
+var _ slog.Handler = (*handler)(nil)
@@ -0,0 +1,31 @@
+package xslog_test
+
+import (
+	"log/slog"
+	"os"
+
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/std/xslog"
+)
+
+func ExampleNewHandler() {
+	opts := &slog.HandlerOptions{
+		// remove the time to get a deterministic output
+		ReplaceAttr: func(_ []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+
+			return attr
+		},
+	}
+
+	logger := slog.New(xslog.NewHandler(slog.NewTextHandler(os.Stdout, opts)))
+	logger.Info("user logged in",
+		slog.Any("name", typact.Some("gopher")),
+		slog.Any("email", typact.None[string]()),
+	)
+
+	// Output:
+	// level=INFO msg="user logged in" name=gopher
+}
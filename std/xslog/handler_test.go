@@ -0,0 +1,82 @@
+package xslog
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"go.l0nax.org/typact"
+)
+
+type secret string
+
+// LogValue implements [slog.LogValuer].
+func (secret) LogValue() slog.Value {
+	return slog.StringValue("***")
+}
+
+// newTestLogger returns a logger writing JSON into buf.
+func newTestLogger(buf *bytes.Buffer) *slog.Logger {
+	opts := &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+
+			return attr
+		},
+	}
+
+	return slog.New(NewHandler(slog.NewJSONHandler(buf, opts)))
+}
+
+func TestHandler(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := newTestLogger(&buf).With(
+		slog.Any("with_none", typact.None[int]()),
+		slog.Any("with_some", typact.Some(1)),
+	)
+
+	logger.Info("msg",
+		slog.Any("none", typact.None[string]()),
+		slog.Any("some", typact.Some("foo")),
+		slog.Any("secret", typact.Some(secret("password"))),
+		slog.Group("group",
+			slog.Any("none", typact.None[int]()),
+			slog.Any("some", typact.Some(2)),
+		),
+		slog.String("plain", "bar"),
+	)
+
+	expected := `{"level":"INFO","msg":"msg","with_some":1,"some":"foo","secret":"***","group":{"some":2},"plain":"bar"}`
+	if got := strings.TrimSpace(buf.String()); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestHandler_withGroup(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := newTestLogger(&buf).WithGroup("req")
+	logger.Info("msg", slog.Any("id", typact.Some(1)), slog.Any("user", typact.None[string]()))
+
+	expected := `{"level":"INFO","msg":"msg","req":{"id":1}}`
+	if got := strings.TrimSpace(buf.String()); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestHandler_enabled(t *testing.T) {
+	h := NewHandler(slog.NewTextHandler(&bytes.Buffer{}, &slog.HandlerOptions{Level: slog.LevelWarn}))
+
+	if h.Enabled(context.Background(), slog.LevelInfo) {
+		t.Error("expected info level to be disabled")
+	}
+
+	if !h.Enabled(context.Background(), slog.LevelError) {
+		t.Error("expected error level to be enabled")
+	}
+}
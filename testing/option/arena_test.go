@@ -0,0 +1,77 @@
+//go:build go1.20 && goexperiment.arenas
+// +build go1.20,goexperiment.arenas
+
+package option_test
+
+import (
+	"arena"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/std/clone"
+	"go.l0nax.org/typact/std/option"
+)
+
+var _ = Describe("Arena", func() {
+	var a *arena.Arena
+
+	BeforeEach(func() {
+		a = arena.NewArena()
+	})
+
+	It("should clone None into an arena", func() {
+		cpy := typact.None[[]string]().CloneInto(a)
+		a.Free()
+
+		Expect(cpy.IsNone()).To(BeTrue())
+	})
+
+	It("should clone the value into an arena", func() {
+		vv := typact.Some(plainStruct{
+			Tags:   []string{"foo"},
+			Labels: map[string]int{"bar": 1},
+		})
+		cpy := vv.CloneInto(a)
+
+		// change vv
+		vv.Unwrap().Tags[0] = "changed"
+
+		heap := option.MoveToHeap(cpy)
+		a.Free()
+
+		Expect(heap.Unwrap()).To(Equal(plainStruct{
+			Tags:   []string{"foo"},
+			Labels: map[string]int{"bar": 1},
+		}))
+	})
+
+	It("should clone an Option field into an arena", func() {
+		src := struct {
+			Tags typact.Option[[]string]
+		}{
+			Tags: typact.Some([]string{"foo"}),
+		}
+
+		cpy := clone.DeepWithOptions(src, clone.WithArena(a))
+		Expect(cpy).To(Equal(src))
+
+		// change src
+		src.Tags.Unwrap()[0] = "changed"
+
+		heap := clone.Deep(cpy)
+		a.Free()
+
+		Expect(heap.Tags.Unwrap()).To(Equal([]string{"foo"}))
+	})
+
+	It("should allocate the Option in an arena", func() {
+		opt := option.NewIn(a, "foo")
+		Expect(opt.Unwrap()).To(Equal("foo"))
+
+		heap := option.MoveToHeap(*opt)
+		a.Free()
+
+		Expect(heap.Unwrap()).To(Equal("foo"))
+	})
+})
@@ -0,0 +1,61 @@
+//go:build go1.24
+// +build go1.24
+
+package option_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.l0nax.org/typact"
+)
+
+var _ = Describe("Appender", func() {
+	Context("AppendBinary", func() {
+		It("should append the binary representation", func() {
+			data, err := typact.Some("foo").AppendBinary([]byte("prefix"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal([]byte("prefix\x01foo")))
+
+			data, err = typact.None[string]().AppendBinary([]byte("prefix"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal([]byte("prefix\x00")))
+		})
+
+		It("should match MarshalBinary", func() {
+			opt := typact.Some(time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC))
+
+			expected, err := opt.MarshalBinary()
+			Expect(err).ToNot(HaveOccurred())
+
+			data, err := opt.AppendBinary(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal(expected))
+		})
+	})
+
+	Context("AppendText", func() {
+		It("should append nothing for None", func() {
+			data, err := typact.None[int]().AppendText([]byte("prefix"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal([]byte("prefix")))
+		})
+
+		It("should append the text representation", func() {
+			data, err := typact.Some(42).AppendText([]byte("n="))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal([]byte("n=42")))
+
+			data, err = typact.Some(time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC)).AppendText([]byte("t="))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal([]byte("t=2024-12-23T10:00:00Z")))
+		})
+
+		It("should fail for unsupported types", func() {
+			_, err := typact.Some([]int{1}).AppendText(nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,167 @@
+package option_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.l0nax.org/typact"
+)
+
+// binaryRoundTrip marshals in using MarshalBinary and decodes it into a new Option.
+func binaryRoundTrip[T any](in typact.Option[T]) typact.Option[T] {
+	data, err := in.MarshalBinary()
+	Expect(err).ToNot(HaveOccurred())
+
+	var out typact.Option[T]
+	Expect(out.UnmarshalBinary(data)).To(Succeed())
+
+	return out
+}
+
+var _ = Describe("Binary", func() {
+	type Inner struct {
+		Name string
+		Tags []string
+	}
+
+	Context("MarshalBinary", func() {
+		It("should encode None as single presence byte", func() {
+			data, err := typact.None[string]().MarshalBinary()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal([]byte{0}))
+		})
+
+		DescribeTable("should use a compact encoding for scalars",
+			func(opt interface{ MarshalBinary() ([]byte, error) }, expected []byte) {
+				data, err := opt.MarshalBinary()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(data).To(Equal(expected))
+			},
+			Entry("string", typact.Some("foo"), []byte{1, 'f', 'o', 'o'}),
+			Entry("empty string", typact.Some(""), []byte{1}),
+			Entry("bytes", typact.Some([]byte{0xca, 0xfe}), []byte{1, 0xca, 0xfe}),
+			Entry("bool true", typact.Some(true), []byte{1, 1}),
+			Entry("bool false", typact.Some(false), []byte{1, 0}),
+			Entry("int", typact.Some(-1), []byte{1, 1}),
+			Entry("uint8", typact.Some[uint8](200), []byte{1, 0xc8, 0x01}),
+			Entry("float32", typact.Some[float32](1), []byte{1, 0, 0, 0x80, 0x3f}),
+		)
+
+		It("should delegate to the BinaryMarshaler of T", func() {
+			ts := time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC)
+
+			expected, err := ts.MarshalBinary()
+			Expect(err).ToNot(HaveOccurred())
+
+			data, err := typact.Some(ts).MarshalBinary()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal(append([]byte{1}, expected...)))
+		})
+	})
+
+	Context("UnmarshalBinary", func() {
+		It("should round trip None", func() {
+			Expect(binaryRoundTrip(typact.None[int]()).IsNone()).To(BeTrue())
+			Expect(binaryRoundTrip(typact.None[Inner]()).IsNone()).To(BeTrue())
+		})
+
+		It("should round trip scalars", func() {
+			Expect(binaryRoundTrip(typact.Some("foo")).Unwrap()).To(Equal("foo"))
+			Expect(binaryRoundTrip(typact.Some("")).Unwrap()).To(BeEmpty())
+			Expect(binaryRoundTrip(typact.Some([]byte("bar"))).Unwrap()).To(Equal([]byte("bar")))
+			Expect(binaryRoundTrip(typact.Some(true)).Unwrap()).To(BeTrue())
+			Expect(binaryRoundTrip(typact.Some(math.MinInt)).Unwrap()).To(Equal(math.MinInt))
+			Expect(binaryRoundTrip(typact.Some[int8](math.MinInt8)).Unwrap()).To(BeEquivalentTo(math.MinInt8))
+			Expect(binaryRoundTrip(typact.Some[int16](-300)).Unwrap()).To(BeEquivalentTo(-300))
+			Expect(binaryRoundTrip(typact.Some[int32](math.MaxInt32)).Unwrap()).To(BeEquivalentTo(math.MaxInt32))
+			Expect(binaryRoundTrip(typact.Some[int64](math.MaxInt64)).Unwrap()).To(BeEquivalentTo(math.MaxInt64))
+			Expect(binaryRoundTrip(typact.Some[uint](math.MaxUint)).Unwrap()).To(BeEquivalentTo(uint(math.MaxUint)))
+			Expect(binaryRoundTrip(typact.Some[uint16](math.MaxUint16)).Unwrap()).To(BeEquivalentTo(math.MaxUint16))
+			Expect(binaryRoundTrip(typact.Some[uint32](7)).Unwrap()).To(BeEquivalentTo(7))
+			Expect(binaryRoundTrip(typact.Some[uint64](math.MaxUint64)).Unwrap()).To(BeEquivalentTo(uint64(math.MaxUint64)))
+			Expect(binaryRoundTrip(typact.Some[float32](1.5)).Unwrap()).To(BeEquivalentTo(1.5))
+			Expect(binaryRoundTrip(typact.Some(math.Pi)).Unwrap()).To(Equal(math.Pi))
+		})
+
+		It("should round trip BinaryMarshaler types", func() {
+			ts := time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC)
+			Expect(binaryRoundTrip(typact.Some(ts)).Unwrap()).To(BeTemporally("==", ts))
+
+			num, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+			out := binaryRoundTrip(typact.Some(num))
+			Expect(out.Unwrap().Cmp(num)).To(BeZero())
+		})
+
+		It("should round trip other types using gob", func() {
+			in := typact.Some(Inner{Name: "foo", Tags: []string{"a", "b"}})
+			Expect(binaryRoundTrip(in).Unwrap()).To(Equal(in.Unwrap()))
+
+			nested := typact.Some(typact.Some(map[string]int{"a": 1}))
+			Expect(binaryRoundTrip(nested).Unwrap().Unwrap()).To(Equal(map[string]int{"a": 1}))
+
+			Expect(binaryRoundTrip(typact.Some(typact.None[int]())).Unwrap().IsNone()).To(BeTrue())
+		})
+
+		DescribeTable("should reject invalid data",
+			func(data []byte) {
+				opt := typact.Some[int16](5)
+
+				Expect(opt.UnmarshalBinary(data)).ToNot(Succeed())
+				Expect(opt.IsNone()).To(BeTrue())
+				Expect(opt.UnwrapOrZero()).To(BeZero())
+			},
+			Entry("empty", []byte{}),
+			Entry("invalid presence byte", []byte{2}),
+			Entry("trailing data after None", []byte{0, 1}),
+			Entry("missing value", []byte{1}),
+			Entry("trailing data after value", []byte{1, 2, 3}),
+			Entry("overflow", []byte{1, 0x80, 0x80, 0x04}),
+		)
+
+		It("should reject invalid bools and floats", func() {
+			var b typact.Option[bool]
+			Expect(b.UnmarshalBinary([]byte{1, 2})).ToNot(Succeed())
+
+			var f typact.Option[float64]
+			Expect(f.UnmarshalBinary([]byte{1, 0, 0, 0, 0})).ToNot(Succeed())
+		})
+	})
+
+	Context("Gob", func() {
+		type Message struct {
+			ID      int
+			Name    typact.Option[string]
+			Missing typact.Option[string]
+			Inner   typact.Option[Inner]
+			At      typact.Option[time.Time]
+		}
+
+		It("should round trip a struct with Options", func() {
+			in := Message{
+				ID:    1,
+				Name:  typact.Some(""),
+				Inner: typact.Some(Inner{Name: "foo", Tags: []string{"a"}}),
+				At:    typact.Some(time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC)),
+			}
+
+			var buf bytes.Buffer
+			Expect(gob.NewEncoder(&buf).Encode(in)).To(Succeed())
+
+			var out Message
+			Expect(gob.NewDecoder(&buf).Decode(&out)).To(Succeed())
+
+			Expect(out.ID).To(Equal(1))
+			Expect(out.Name.IsSome()).To(BeTrue())
+			Expect(out.Name.Unwrap()).To(BeEmpty())
+			Expect(out.Missing.IsNone()).To(BeTrue())
+			Expect(out.Inner.Unwrap()).To(Equal(in.Inner.Unwrap()))
+			Expect(out.At.Unwrap()).To(BeTemporally("==", in.At.Unwrap()))
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/std/clone"
 )
 
 var _ = Describe("Clone", func() {
@@ -92,17 +93,15 @@ var _ = Describe("Clone", func() {
 			Expect(cpy).To(BeEquivalentTo("cpy: foo bar"))
 		})
 
-		It("should NOT use the custom Clone method on pointer", func() {
+		It("should use the custom Clone method on pointer", func() {
 			data := myStrWithClone("foo bar")
 			vv := typact.Some(&data)
 			cpy := vv.Clone().Unwrap()
 
-			// NOTE: It is not directly possible to call the custom Clone[T] method
-			// and since [myStrWithClone] implements the [std.Clone] interface only
-			// for the non-pointer variant, it should fallback to our implementation.
-			//
-			// If someone wants to support this specific usecase, please create an issue.
-			Expect(*cpy).To(BeEquivalentTo("foo bar"))
+			// NOTE: [std.Cloner] is honoured at every depth, thus the pointee
+			// is cloned by calling its Clone method.
+			Expect(*cpy).To(BeEquivalentTo("cpy: foo bar"))
+			Expect(cpy).ToNot(BeIdenticalTo(&data))
 		})
 
 		It("should clone an alias to primitive type", func() {
@@ -277,26 +276,43 @@ var _ = Describe("Clone", func() {
 			})
 		})
 
-		/*
-			// XXX: This will/ must panic!
-					It("should call the custom Clone method on ptr ref", func() {
-						tt := time.Now()
-
-						vv := typact.Some(&myStruct{
-							CreatedAt: tt,
-							Data:      "foo bar",
-						})
-						cpy := vv.Clone().Unwrap()
-
-						// change vv
-						vv.Unwrap().CreatedAt = tt.AddDate(0, 0, 1)
-
-						Expect(*cpy).To(BeEquivalentTo(myStruct{
-							CreatedAt: tt,
-							Data:      "foo bar",
-						}))
-					})
-		*/
+		It("should call the custom Clone method on ptr ref", func() {
+			tt := time.Now()
+
+			vv := typact.Some(&myStruct{
+				CreatedAt: tt,
+				Data:      "foo bar",
+			})
+			cpy := vv.Clone().Unwrap()
+
+			// change vv
+			vv.Unwrap().CreatedAt = tt.AddDate(0, 0, 1)
+
+			Expect(*cpy).To(BeEquivalentTo(myStruct{
+				CreatedAt: tt,
+				Data:      "foo bar",
+			}))
+		})
+
+		It("should clone structs without Cloner", func() {
+			vv := typact.Some(plainStruct{
+				Tags:   []string{"foo"},
+				Labels: map[string]int{"bar": 1},
+				Arr:    [2][]int{{1}, {2}},
+			})
+			cpy := vv.Clone().Unwrap()
+
+			// change vv
+			vv.Unwrap().Tags[0] = "changed"
+			vv.Unwrap().Labels["bar"] = 2
+			vv.Unwrap().Arr[0][0] = 100
+
+			Expect(cpy).To(Equal(plainStruct{
+				Tags:   []string{"foo"},
+				Labels: map[string]int{"bar": 1},
+				Arr:    [2][]int{{1}, {2}},
+			}))
+		})
 	})
 
 	Describe("Custom type and slice wrapper", func() {
@@ -424,8 +440,117 @@ var _ = Describe("Clone", func() {
 			})))
 		})
 	})
+
+	Describe("CloneWithOptions", func() {
+		It("should return None for None", func() {
+			cpy, err := typact.None[plainStruct]().CloneWithOptions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cpy.IsNone()).To(BeTrue())
+		})
+
+		It("should honor struct tags", func() {
+			vv := typact.Some(taggedStruct{
+				Shared:  []int{1},
+				Skipped: []int{2},
+				Deep:    []int{3},
+			})
+
+			cpy, err := vv.CloneWithOptions()
+			Expect(err).ToNot(HaveOccurred())
+
+			// change vv
+			vv.Unwrap().Shared[0] = 100
+			vv.Unwrap().Deep[0] = 100
+
+			Expect(cpy.Unwrap()).To(Equal(taggedStruct{
+				Shared: []int{100},
+				Deep:   []int{3},
+			}))
+		})
+
+		It("should return an error if the max depth is exceeded", func() {
+			vv := typact.Some(plainStruct{
+				Arr: [2][]int{{1}, {2}},
+			})
+
+			_, err := vv.CloneWithOptions(clone.WithMaxDepth(2))
+			Expect(err).To(MatchError(clone.ErrMaxDepth))
+
+			_, err = vv.CloneWithOptions(clone.WithMaxDepth(3))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should use registered cloners", func() {
+			clone.RegisterCloner(func(v registeredType) registeredType {
+				return registeredType{Data: "registered: " + v.Data}
+			})
+
+			cpy, err := typact.Some([]registeredType{{Data: "foo"}}).CloneWithOptions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cpy.Unwrap()).To(Equal([]registeredType{{Data: "registered: foo"}}))
+		})
+	})
+
+	Describe("clone.Deep", func() {
+		It("should clone a cycle through an Option", func() {
+			first := &optNode{Name: "first"}
+			second := &optNode{Name: "second", Next: typact.Some(first)}
+			first.Next = typact.Some(second)
+
+			cpy := clone.Deep(first)
+			Expect(cpy).ToNot(BeIdenticalTo(first))
+			Expect(cpy.Name).To(Equal("first"))
+
+			next := cpy.Next.Unwrap()
+			Expect(next).ToNot(BeIdenticalTo(second))
+			Expect(next.Name).To(Equal("second"))
+			Expect(next.Next.Unwrap()).To(BeIdenticalTo(cpy))
+		})
+
+		It("should honor the max depth behind an Option", func() {
+			var chain *optNode
+			for range 100 {
+				chain = &optNode{Next: typact.Some(chain)}
+			}
+
+			_, err := clone.TryDeep(optWrap{Inner: typact.Some(chain)}, clone.WithMaxDepth(10))
+			Expect(err).To(MatchError(clone.ErrMaxDepth))
+
+			_, err = clone.TryDeep(optWrap{Inner: typact.Some(chain)})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should honor WithUnexported behind an Option", func() {
+			src := optWrap{
+				Private: typact.Some(privStruct{data: []int{1}}),
+			}
+
+			shared := clone.Deep(src)
+			cpy := clone.DeepWithOptions(src, clone.WithUnexported())
+
+			// change src
+			src.Private.Unwrap().data[0] = 100
+
+			Expect(shared.Private.Unwrap().data).To(Equal([]int{100}))
+			Expect(cpy.Private.Unwrap().data).To(Equal([]int{1}))
+		})
+	})
 })
 
+type optNode struct {
+	Name string
+	Next typact.Option[*optNode]
+}
+
+type optWrap struct {
+	Inner   typact.Option[*optNode]
+	Private typact.Option[privStruct]
+}
+
+type privStruct struct {
+	data []int
+}
+
 type myStrSliceAlias = []string
 
 type myStrSlice []string
@@ -469,6 +594,22 @@ type MyData struct {
 	Data string
 }
 
+type plainStruct struct {
+	Tags   []string
+	Labels map[string]int
+	Arr    [2][]int
+}
+
+type taggedStruct struct {
+	Shared  []int `typact:"shallow"`
+	Skipped []int `typact:"skip"`
+	Deep    []int
+}
+
+type registeredType struct {
+	Data string
+}
+
 func (m *MyData) Clone() *MyData {
 	return &MyData{
 		Data: "cpy: " + m.Data,
@@ -0,0 +1,128 @@
+package option_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+)
+
+// fakeDriverName is the name of the in-memory driver registered
+// for the database tests.
+const fakeDriverName = "typact-fake"
+
+func init() {
+	sql.Register(fakeDriverName, &fakeDriver{})
+}
+
+// fakeDriver is a minimal in-memory [driver.Driver].
+//
+// Every query returns a single row with a single column, whose value
+// has been set with [fakeDriver.SetResult]. The arguments of the last
+// executed statement are recorded and can be retrieved with [fakeDriver.LastArgs].
+type fakeDriver struct {
+	mu       sync.Mutex
+	result   driver.Value
+	lastArgs []driver.Value
+}
+
+// openFakeDB opens a new [sql.DB] using the fake driver.
+func openFakeDB() (*sql.DB, *fakeDriver) {
+	db, err := sql.Open(fakeDriverName, "")
+	if err != nil {
+		panic(err)
+	}
+
+	return db, db.Driver().(*fakeDriver)
+}
+
+// SetResult sets the value returned by all following queries.
+func (d *fakeDriver) SetResult(val driver.Value) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.result = val
+}
+
+// LastArgs returns the arguments of the last executed statement.
+func (d *fakeDriver) LastArgs() []driver.Value {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.lastArgs
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) {
+	return &fakeConn{drv: d}, nil
+}
+
+type fakeConn struct {
+	drv *fakeDriver
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{drv: c.drv}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeStmt struct {
+	drv *fakeDriver
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.drv.mu.Lock()
+	defer s.drv.mu.Unlock()
+
+	s.drv.lastArgs = args
+
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.drv.mu.Lock()
+	defer s.drv.mu.Unlock()
+
+	s.drv.lastArgs = args
+
+	return &fakeRows{val: s.drv.result}, nil
+}
+
+type fakeRows struct {
+	val  driver.Value
+	done bool
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"value"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+
+	r.done = true
+	dest[0] = r.val
+
+	return nil
+}
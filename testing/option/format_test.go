@@ -0,0 +1,103 @@
+package option_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.l0nax.org/typact"
+)
+
+var _ = Describe("Format", func() {
+	type point struct {
+		X, Y int
+	}
+
+	DescribeTable("should format",
+		func(format string, value any, expected string) {
+			Expect(fmt.Sprintf(format, value)).To(Equal(expected))
+		},
+		Entry("%v Some", "%v", typact.Some(5), "Some(5)"),
+		Entry("%v None", "%v", typact.None[int](), "None"),
+		Entry("%s Some", "%s", typact.Some("foo"), "Some(foo)"),
+		Entry("%+v struct", "%+v", typact.Some(point{X: 1, Y: 2}), "Some({X:1 Y:2})"),
+		Entry("%v nested", "%v", typact.Some(typact.Some(5)), "Some(Some(5))"),
+		Entry("%d", "%d", typact.Some(42), "42"),
+		Entry("%05d", "%05d", typact.Some(42), "00042"),
+		Entry("%x", "%x", typact.Some(255), "ff"),
+		Entry("%.2f", "%.2f", typact.Some(3.14159), "3.14"),
+		Entry("%q", "%q", typact.Some("foo"), `"foo"`),
+		Entry("%q None", "%q", typact.None[string](), "None"),
+		Entry("%t", "%t", typact.Some(true), "true"),
+		Entry("%#v Some", "%#v", typact.Some(5), "typact.Some[int](5)"),
+		Entry("%#v None", "%#v", typact.None[int](), "typact.None[int]()"),
+		Entry("%#v string", "%#v", typact.Some("foo"), `typact.Some[string]("foo")`),
+		Entry("%#v slice", "%#v", typact.Some([]int{1}), "typact.Some[[]int]([]int{1})"),
+		Entry("%#v nested", "%#v", typact.Some(typact.None[int]()),
+			"typact.Some[typact.Option[int]](typact.None[int]())"),
+	)
+
+	It("should use the same output for String and %v", func() {
+		opt := typact.Some(time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC))
+
+		Expect(fmt.Sprintf("%v", opt)).To(Equal(opt.String()))
+		Expect(fmt.Sprint(typact.None[int]())).To(Equal(typact.None[int]().String()))
+	})
+
+	It("should format struct fields", func() {
+		type data struct {
+			Name typact.Option[string]
+		}
+
+		Expect(fmt.Sprintf("%+v", data{Name: typact.Some("foo")})).To(Equal("{Name:Some(foo)}"))
+		Expect(fmt.Sprintf("%#v", data{})).To(ContainSubstring("Name:typact.None[string]()"))
+	})
+})
+
+var _ = Describe("ParseOption", func() {
+	It("should parse the output of String", func() {
+		for _, opt := range []typact.Option[int]{typact.Some(5), typact.Some(-1), typact.None[int]()} {
+			got, err := typact.ParseOption[int](opt.String())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(got).To(Equal(opt))
+		}
+
+		str, err := typact.ParseOption[string]("Some(foo (bar))")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(str).To(Equal(typact.Some("foo (bar)")))
+
+		ts := time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC)
+
+		got, err := typact.ParseOption[time.Time](fmt.Sprintf("Some(%s)", ts.Format(time.RFC3339)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(got.Unwrap()).To(BeTemporally("==", ts))
+	})
+
+	It("should parse the output of GoString", func() {
+		for _, opt := range []typact.Option[string]{typact.Some(`a "quoted" string`), typact.Some(""), typact.None[string]()} {
+			got, err := typact.ParseOption[string](opt.GoString())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(got).To(Equal(opt))
+		}
+
+		got, err := typact.ParseOption[float64](typact.Some(1.5).GoString())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(got).To(Equal(typact.Some(1.5)))
+	})
+
+	DescribeTable("should reject invalid input",
+		func(input string) {
+			_, err := typact.ParseOption[int](input)
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, typact.ErrInvalidOptionString)).To(BeTrue())
+		},
+		Entry("empty", ""),
+		Entry("unknown", "Maybe(5)"),
+		Entry("missing paren", "Some(5"),
+		Entry("invalid value", "Some(foo)"),
+		Entry("type mismatch", "typact.None[string]()"),
+	)
+})
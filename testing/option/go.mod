@@ -1,11 +1,13 @@
 module go.l0nax.org/typact/testing/option
 
-go 1.22.3
+go 1.23.1
 
 require (
+	github.com/BurntSushi/toml v1.4.0
 	github.com/onsi/ginkgo/v2 v2.15.0
 	github.com/onsi/gomega v1.31.1
 	go.l0nax.org/typact v0.0.0-20240124124719-7814e9856468
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -17,7 +19,6 @@ require (
 	golang.org/x/sys v0.15.0 // indirect
 	golang.org/x/text v0.14.0 // indirect
 	golang.org/x/tools v0.16.1 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
 replace go.l0nax.org/typact => ../../
@@ -0,0 +1,87 @@
+//go:build go1.27 && goexperiment.jsonv2
+// +build go1.27,goexperiment.jsonv2
+
+// NOTE: See json_v2.go of the typact package for the required Go version.
+
+package option_test
+
+import (
+	"bytes"
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.l0nax.org/typact"
+)
+
+var _ = Describe("JSON v2", func() {
+	type Inner struct {
+		Port typact.Option[int] `json:"port"`
+	}
+
+	type Config struct {
+		Name    typact.Option[string]    `json:"name"`
+		Debug   typact.Option[bool]      `json:"debug,omitempty"`
+		Inner   typact.Option[Inner]     `json:"inner,omitzero"`
+		Patch   typact.Nullable[string]  `json:"patch,omitzero"`
+		Omitted typact.OmitNone[float64] `json:"omitted,omitempty"`
+	}
+
+	Context("MarshalJSONTo", func() {
+		It("should encode None as null", func() {
+			data, err := jsonv2.Marshal(Config{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(MatchJSON(`{"name": null}`))
+		})
+
+		It("should encode Some values", func() {
+			data, err := jsonv2.Marshal(Config{
+				Name:    typact.Some("app"),
+				Debug:   typact.Some(false),
+				Inner:   typact.Some(Inner{Port: typact.Some(80)}),
+				Patch:   typact.Null[string](),
+				Omitted: typact.OmitNoneFrom(typact.Some(1.5)),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(MatchJSON(`{"name": "app", "debug": false, "inner": {"port": 80}, "patch": null, "omitted": 1.5}`))
+		})
+
+		It("should honour the options of the encoder", func() {
+			var buf bytes.Buffer
+
+			err := jsonv2.MarshalWrite(&buf, typact.Some(map[string]int{"b": 2, "a": 1}), jsonv2.Deterministic(true))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buf.String()).To(Equal(`{"a":1,"b":2}`))
+		})
+	})
+
+	Context("UnmarshalJSONFrom", func() {
+		It("should decode values", func() {
+			var cfg Config
+
+			err := jsonv2.Unmarshal([]byte(`{"name": "app", "debug": null, "inner": {"port": 80}, "patch": null, "omitted": 2}`), &cfg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cfg.Name.Unwrap()).To(Equal("app"))
+			Expect(cfg.Debug.IsNone()).To(BeTrue())
+			Expect(cfg.Inner.Unwrap().Port.Unwrap()).To(Equal(80))
+			Expect(cfg.Patch.IsNull()).To(BeTrue())
+			Expect(cfg.Omitted.Option().Unwrap()).To(Equal(2.0))
+		})
+
+		It("should keep the decoder usable", func() {
+			dec := jsontext.NewDecoder(bytes.NewReader([]byte(`null 1 "foo"`)))
+
+			var a, b typact.Option[int]
+			Expect(jsonv2.UnmarshalDecode(dec, &a)).To(Succeed())
+			Expect(jsonv2.UnmarshalDecode(dec, &b)).To(Succeed())
+			Expect(a.IsNone()).To(BeTrue())
+			Expect(b.Unwrap()).To(Equal(1))
+
+			c := typact.Some(5)
+			Expect(jsonv2.UnmarshalDecode(dec, &c)).ToNot(Succeed())
+			Expect(c.IsNone()).To(BeTrue())
+		})
+	})
+})
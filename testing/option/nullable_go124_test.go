@@ -0,0 +1,44 @@
+//go:build go1.24
+// +build go1.24
+
+package option_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.l0nax.org/typact"
+)
+
+var _ = Describe("Nullable omitzero", func() {
+	type Patch struct {
+		Name typact.Nullable[string] `json:"name,omitzero"`
+		Age  typact.Nullable[int]    `json:"age,omitzero"`
+		Tags typact.Nullable[[]int]  `json:"tags,omitzero"`
+	}
+
+	It("should omit Unset values", func() {
+		data, err := json.Marshal(Patch{
+			Name: typact.Null[string](),
+			Age:  typact.Value(0),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"name": null, "age": 0}`))
+	})
+
+	It("should round trip", func() {
+		in := Patch{
+			Name: typact.Null[string](),
+			Tags: typact.Value([]int{1}),
+		}
+
+		data, err := json.Marshal(in)
+		Expect(err).ToNot(HaveOccurred())
+
+		var out Patch
+		Expect(json.Unmarshal(data, &out)).To(Succeed())
+		Expect(out).To(Equal(in))
+	})
+})
@@ -0,0 +1,129 @@
+package option_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.l0nax.org/typact"
+)
+
+var _ = Describe("Nullable", func() {
+	Context("States", func() {
+		It("should be Unset by default", func() {
+			var n typact.Nullable[int]
+
+			Expect(n.IsUnset()).To(BeTrue())
+			Expect(n.IsNull()).To(BeFalse())
+			Expect(n.IsValue()).To(BeFalse())
+			Expect(n.IsSet()).To(BeFalse())
+			Expect(n.IsZero()).To(BeTrue())
+			Expect(n).To(Equal(typact.Unset[int]()))
+		})
+
+		It("should report Null", func() {
+			n := typact.Null[int]()
+
+			Expect(n.IsUnset()).To(BeFalse())
+			Expect(n.IsNull()).To(BeTrue())
+			Expect(n.IsValue()).To(BeFalse())
+			Expect(n.IsSet()).To(BeTrue())
+			Expect(n.IsZero()).To(BeFalse())
+		})
+
+		It("should report Value", func() {
+			n := typact.Value(0)
+
+			Expect(n.IsUnset()).To(BeFalse())
+			Expect(n.IsNull()).To(BeFalse())
+			Expect(n.IsValue()).To(BeTrue())
+			Expect(n.IsSet()).To(BeTrue())
+			Expect(n.IsZero()).To(BeFalse())
+		})
+	})
+
+	Context("Unwrap", func() {
+		It("should return the value", func() {
+			n := typact.Value("foo")
+
+			Expect(n.Unwrap()).To(Equal("foo"))
+			Expect(n.UnwrapOr("bar")).To(Equal("foo"))
+			Expect(n.UnsafeUnwrap()).To(Equal("foo"))
+
+			val, ok := n.Deconstruct()
+			Expect(ok).To(BeTrue())
+			Expect(val).To(Equal("foo"))
+		})
+
+		It("should panic if not set to a value", func() {
+			Expect(func() { typact.Null[string]().Unwrap() }).To(Panic())
+			Expect(func() { typact.Unset[string]().Unwrap() }).To(Panic())
+			Expect(typact.Null[string]().UnwrapOr("bar")).To(Equal("bar"))
+
+			_, ok := typact.Null[string]().Deconstruct()
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("Option", func() {
+		It("should convert to Option", func() {
+			Expect(typact.Value(1).Option()).To(Equal(typact.Some(1)))
+			Expect(typact.Null[int]().Option().IsNone()).To(BeTrue())
+			Expect(typact.Unset[int]().Option().IsNone()).To(BeTrue())
+		})
+
+		It("should convert from Option", func() {
+			Expect(typact.NullableFromOption(typact.Some(1))).To(Equal(typact.Value(1)))
+			Expect(typact.NullableFromOption(typact.None[int]())).To(Equal(typact.Null[int]()))
+		})
+	})
+
+	Context("String", func() {
+		It("should format all states", func() {
+			Expect(typact.Unset[int]().String()).To(Equal("Unset"))
+			Expect(typact.Null[int]().String()).To(Equal("Null"))
+			Expect(typact.Value(5).String()).To(Equal("Value(5)"))
+		})
+	})
+
+	Context("JSON", func() {
+		type Patch struct {
+			Name  typact.Nullable[string]   `json:"name"`
+			Age   typact.Nullable[int]      `json:"age"`
+			Tags  typact.Nullable[[]string] `json:"tags"`
+			Email typact.Nullable[string]   `json:"email"`
+		}
+
+		It("should distinguish absent, null and value", func() {
+			var p Patch
+
+			err := json.Unmarshal([]byte(`{"name": null, "age": 0, "tags": ["a"]}`), &p)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(p.Name.IsNull()).To(BeTrue())
+			Expect(p.Age.IsValue()).To(BeTrue())
+			Expect(p.Age.Unwrap()).To(BeZero())
+			Expect(p.Tags.Unwrap()).To(Equal([]string{"a"}))
+			Expect(p.Email.IsUnset()).To(BeTrue())
+		})
+
+		It("should encode Unset and Null as null", func() {
+			data, err := json.Marshal(Patch{
+				Name: typact.Null[string](),
+				Age:  typact.Value(3),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(MatchJSON(`{"name": null, "age": 3, "tags": null, "email": null}`))
+		})
+
+		It("should reset to Unset on error", func() {
+			n := typact.Value(1)
+
+			err := json.Unmarshal([]byte(`"foo"`), &n)
+			Expect(err).To(HaveOccurred())
+			Expect(n.IsUnset()).To(BeTrue())
+			Expect(n.UnsafeUnwrap()).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,88 @@
+package option_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.l0nax.org/typact"
+)
+
+var _ = Describe("OmitNone", func() {
+	type User struct {
+		Name  typact.OmitNone[string]   `json:"name,omitempty"`
+		Age   typact.OmitNone[int]      `json:"age,omitempty"`
+		Tags  typact.OmitNone[[]string] `json:"tags,omitempty"`
+		Email typact.OmitNone[string]   `json:"email"`
+	}
+
+	Context("Conversion", func() {
+		It("should wrap None", func() {
+			o := typact.OmitNoneFrom(typact.None[int]())
+
+			Expect(o).To(BeNil())
+			Expect(o.IsNone()).To(BeTrue())
+			Expect(o.IsSome()).To(BeFalse())
+			Expect(o.Option().IsNone()).To(BeTrue())
+			Expect(o.String()).To(Equal("None"))
+		})
+
+		It("should wrap Some", func() {
+			o := typact.OmitNoneFrom(typact.Some(0))
+
+			Expect(o.IsNone()).To(BeFalse())
+			Expect(o.IsSome()).To(BeTrue())
+			Expect(o.Option()).To(Equal(typact.Some(0)))
+			Expect(o.String()).To(Equal("Some(0)"))
+		})
+	})
+
+	Context("MarshalJSON", func() {
+		It("should omit None with omitempty", func() {
+			data, err := json.Marshal(User{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(MatchJSON(`{"email": null}`))
+		})
+
+		It("should encode Some zero values", func() {
+			data, err := json.Marshal(User{
+				Name:  typact.OmitNoneFrom(typact.Some("")),
+				Age:   typact.OmitNoneFrom(typact.Some(0)),
+				Tags:  typact.OmitNoneFrom(typact.Some([]string{})),
+				Email: typact.OmitNoneFrom(typact.Some("a@b.c")),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(MatchJSON(`{"name": "", "age": 0, "tags": [], "email": "a@b.c"}`))
+		})
+	})
+
+	Context("UnmarshalJSON", func() {
+		It("should decode missing and null values as None", func() {
+			var u User
+
+			err := json.Unmarshal([]byte(`{"name": null}`), &u)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(u.Name.IsNone()).To(BeTrue())
+			Expect(u.Age.IsNone()).To(BeTrue())
+		})
+
+		It("should decode values as Some", func() {
+			var u User
+
+			err := json.Unmarshal([]byte(`{"name": "gopher", "age": 0, "tags": ["a"]}`), &u)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(u.Name.Option()).To(Equal(typact.Some("gopher")))
+			Expect(u.Age.Option()).To(Equal(typact.Some(0)))
+			Expect(u.Tags.Option().Unwrap()).To(Equal([]string{"a"}))
+		})
+
+		It("should reset the value on error", func() {
+			o := typact.OmitNoneFrom(typact.Some(1))
+
+			err := json.Unmarshal([]byte(`"foo"`), &o)
+			Expect(err).To(HaveOccurred())
+			Expect(o.IsNone()).To(BeTrue())
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"encoding"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -557,6 +558,22 @@ var _ = Describe("Option", func() {
 		})
 	})
 
+	Describe("UnmarshalText", func() {
+		It("should support pointer receivers", func() {
+			var opt typact.Option[time.Time]
+
+			Expect(opt.UnmarshalText([]byte("2024-12-23T10:00:00Z"))).To(Succeed())
+			Expect(opt.Unwrap()).To(BeTemporally("==", time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC)))
+		})
+
+		It("should reset the value on error", func() {
+			opt := typact.Some(time.Now())
+
+			Expect(opt.UnmarshalText([]byte("foo"))).ToNot(Succeed())
+			Expect(opt.IsNone()).To(BeTrue())
+		})
+	})
+
 	Describe("Database Scan/Value", func() {
 		DescribeTable("Scanning on string should work",
 			func(inputValue any, wantErr bool, isSome bool) {
@@ -578,8 +595,8 @@ var _ = Describe("Option", func() {
 			},
 			Entry("normal string input", "foo bar", false, true),
 			Entry("null input", nil, false, false),
-			Entry("byte slice as input", []byte("hello world"), true, false),
-			Entry("complete other type", 555, true, false),
+			Entry("byte slice as input", []byte("hello world"), false, true),
+			Entry("complete other type", struct{}{}, true, false),
 		)
 
 		Context("Scan on unsupported type", func() {
@@ -0,0 +1,242 @@
+package option_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/std/xhash"
+)
+
+var errTest = errors.New("test error")
+
+var _ = Describe("Result", func() {
+	Describe("Create a value", func() {
+		It("should return Ok with WrapResult(T, nil)", func() {
+			vv := typact.WrapResult("foo bar", nil)
+			Expect(vv.IsOk()).To(BeTrue())
+			Expect(vv.Unwrap()).To(BeEquivalentTo("foo bar"))
+		})
+
+		It("should return Err with WrapResult(T, err)", func() {
+			vv := typact.WrapResult("foo bar", errTest)
+			Expect(vv.IsErr()).To(BeTrue())
+			Expect(vv.UnsafeUnwrap()).To(BeEquivalentTo(""))
+		})
+
+		It("should return Err with TryWrapResult", func() {
+			vv := typact.TryWrapResult(func() (string, error) {
+				return "foo bar", errTest
+			})
+			Expect(vv.IsErr()).To(BeTrue())
+			Expect(vv.UnwrapErr()).To(MatchError(errTest))
+		})
+
+		It("should be Ok when zero", func() {
+			var vv typact.Result[int]
+			Expect(vv.IsOk()).To(BeTrue())
+			Expect(vv.Unwrap()).To(BeZero())
+		})
+
+		It("should panic when calling Err with nil", func() {
+			Expect(func() {
+				_ = typact.Err[int](nil)
+			}).To(Panic())
+		})
+	})
+
+	Describe("Unwrap", func() {
+		It("should return the value if Ok", func() {
+			Expect(typact.Ok(5).Unwrap()).To(Equal(5))
+		})
+
+		It("should panic with the contained error if Err", func() {
+			Expect(func() {
+				_ = typact.Err[int](errTest).Unwrap()
+			}).To(PanicWith(MatchError(errTest)))
+		})
+
+		It("should panic on UnwrapErr if Ok", func() {
+			Expect(func() {
+				_ = typact.Ok(5).UnwrapErr()
+			}).To(Panic())
+		})
+
+		It("should return the fallback with UnwrapOr", func() {
+			Expect(typact.Err[int](errTest).UnwrapOr(10)).To(Equal(10))
+			Expect(typact.Ok(5).UnwrapOr(10)).To(Equal(5))
+		})
+
+		It("should pass the error to UnwrapOrElse", func() {
+			val := typact.Err[string](errTest).UnwrapOrElse(func(err error) string {
+				return err.Error()
+			})
+			Expect(val).To(Equal("test error"))
+		})
+	})
+
+	Describe("Combinators", func() {
+		It("should map Ok values", func() {
+			vv := typact.Ok(5).Map(func(n int) int { return n * 2 })
+			Expect(vv.Unwrap()).To(Equal(10))
+		})
+
+		It("should not call Map on Err", func() {
+			vv := typact.Err[int](errTest).Map(func(n int) int {
+				Fail("fn should not be called")
+				return n
+			})
+			Expect(vv.UnwrapErr()).To(MatchError(errTest))
+		})
+
+		It("should wrap the error with MapErr", func() {
+			vv := typact.Err[int](errTest).MapErr(func(err error) error {
+				return fmt.Errorf("wrapped: %w", err)
+			})
+			Expect(vv.UnwrapErr()).To(MatchError("wrapped: test error"))
+			Expect(vv.ErrIs(errTest)).To(BeTrue())
+		})
+
+		It("should recover with OrElse", func() {
+			vv := typact.Err[int](errTest).OrElse(func(err error) typact.Result[int] {
+				return typact.Ok(1)
+			})
+			Expect(vv.Unwrap()).To(Equal(1))
+		})
+
+		It("should short-circuit AndThen on Err", func() {
+			vv := typact.Err[int](errTest).AndThen(func(n int) typact.Result[int] {
+				Fail("fn should not be called")
+				return typact.Ok(n)
+			})
+			Expect(vv.IsErr()).To(BeTrue())
+		})
+
+		It("should only call the matching Inspect function", func() {
+			var okCalled, errCalled bool
+
+			typact.Ok(1).
+				Inspect(func(int) { okCalled = true }).
+				InspectErr(func(error) { errCalled = true })
+
+			Expect(okCalled).To(BeTrue())
+			Expect(errCalled).To(BeFalse())
+		})
+	})
+
+	Describe("Errors", func() {
+		It("should support errors.As", func() {
+			vv := typact.Err[int](&fs.PathError{Op: "open", Path: "/foo", Err: fs.ErrNotExist})
+
+			var pathErr *fs.PathError
+			Expect(vv.ErrAs(&pathErr)).To(BeTrue())
+			Expect(pathErr.Path).To(Equal("/foo"))
+			Expect(vv.ErrIs(fs.ErrNotExist)).To(BeTrue())
+		})
+
+		It("should return false for Ok", func() {
+			var pathErr *fs.PathError
+			Expect(typact.Ok(1).ErrAs(&pathErr)).To(BeFalse())
+			Expect(typact.Ok(1).ErrIs(errTest)).To(BeFalse())
+		})
+	})
+
+	Describe("Option conversion", func() {
+		It("should convert Ok into Some", func() {
+			Expect(typact.Ok(5).Ok().Unwrap()).To(Equal(5))
+			Expect(typact.Ok(5).Err().IsNone()).To(BeTrue())
+		})
+
+		It("should convert Err into None", func() {
+			Expect(typact.Err[int](errTest).Ok().IsNone()).To(BeTrue())
+			Expect(typact.Err[int](errTest).Err().Unwrap()).To(MatchError(errTest))
+		})
+
+		It("should convert Option into Result", func() {
+			Expect(typact.Some(5).OkOr(errTest).Unwrap()).To(Equal(5))
+			Expect(typact.None[int]().OkOr(errTest).UnwrapErr()).To(MatchError(errTest))
+			Expect(typact.None[int]().OkOrElse(func() error { return errTest }).IsErr()).To(BeTrue())
+		})
+	})
+
+	Describe("JSON", func() {
+		type MyData struct {
+			Num typact.Result[int] `json:"num"`
+		}
+
+		It("should marshal Ok", func() {
+			b, err := json.Marshal(MyData{Num: typact.Ok(5)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(b)).To(Equal(`{"num":5}`))
+		})
+
+		It("should not marshal Err", func() {
+			_, err := json.Marshal(MyData{Num: typact.Err[int](errTest)})
+			Expect(err).To(MatchError(ContainSubstring("test error")))
+		})
+
+		It("should store the decoding error", func() {
+			var vv typact.Result[int]
+
+			err := vv.UnmarshalJSON([]byte(`"foo"`))
+			Expect(err).To(HaveOccurred())
+			Expect(vv.IsErr()).To(BeTrue())
+
+			err = vv.UnmarshalJSON([]byte(`125`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vv.Unwrap()).To(Equal(125))
+		})
+	})
+
+	Describe("Text", func() {
+		It("should round-trip scalar values", func() {
+			data, err := typact.Ok(125).MarshalText()
+			Expect(err).ToNot(HaveOccurred())
+
+			var vv typact.Result[int]
+			Expect(vv.UnmarshalText(data)).To(Succeed())
+			Expect(vv.Unwrap()).To(Equal(125))
+		})
+	})
+
+	Describe("Database Scan/Value", func() {
+		It("should return the error as Value", func() {
+			_, err := typact.Err[string](errTest).Value()
+			Expect(err).To(MatchError(errTest))
+		})
+
+		It("should scan strings", func() {
+			var vv typact.Result[string]
+
+			Expect(vv.Scan("foo")).To(Succeed())
+			Expect(vv.Unwrap()).To(Equal("foo"))
+		})
+
+		It("should fail on NULL", func() {
+			var vv typact.Result[string]
+
+			Expect(vv.Scan(nil)).ToNot(Succeed())
+			Expect(vv.IsErr()).To(BeTrue())
+		})
+	})
+
+	Describe("Hash", func() {
+		It("should hash Ok and Err differently", func() {
+			h := xhash.NewHasher()
+
+			typact.Ok("test error").Hash(h)
+			r1 := h.Sum64()
+			h.Reset()
+
+			typact.Err[string](errTest).Hash(h)
+			r2 := h.Sum64()
+
+			Expect(r1).ToNot(Equal(r2))
+		})
+	})
+})
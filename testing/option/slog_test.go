@@ -0,0 +1,75 @@
+package option_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.l0nax.org/typact"
+)
+
+type logSecret string
+
+// LogValue implements [slog.LogValuer].
+func (logSecret) LogValue() slog.Value {
+	return slog.StringValue("***")
+}
+
+var _ = Describe("LogValue", func() {
+	var (
+		buf    bytes.Buffer
+		logger *slog.Logger
+	)
+
+	BeforeEach(func() {
+		buf.Reset()
+
+		logger = slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+			ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+				if attr.Key == slog.TimeKey && len(groups) == 0 {
+					return slog.Attr{}
+				}
+
+				return attr
+			},
+		}))
+	})
+
+	output := func() string {
+		return strings.TrimSpace(buf.String())
+	}
+
+	It("should log Some as the inner value", func() {
+		logger.Info("msg",
+			slog.Any("num", typact.Some(5)),
+			slog.Any("str", typact.Some("foo")),
+			slog.Any("nested", typact.Some(typact.Some(true))),
+		)
+
+		Expect(output()).To(Equal(`{"level":"INFO","msg":"msg","num":5,"str":"foo","nested":true}`))
+	})
+
+	It("should respect the LogValuer of the inner value", func() {
+		logger.Info("msg", slog.Any("secret", typact.Some(logSecret("password"))))
+
+		Expect(output()).To(Equal(`{"level":"INFO","msg":"msg","secret":"***"}`))
+	})
+
+	It("should log None as null by default", func() {
+		logger.Info("msg", slog.Any("none", typact.None[int]()))
+
+		Expect(output()).To(Equal(`{"level":"INFO","msg":"msg","none":null}`))
+	})
+
+	It("should omit None with NoneLogOmit", func() {
+		typact.SetNoneLogPolicy(typact.NoneLogOmit)
+		DeferCleanup(typact.SetNoneLogPolicy, typact.NoneLogNull)
+
+		logger.Info("msg", slog.Any("none", typact.None[int]()), slog.Any("some", typact.Some(1)))
+
+		Expect(output()).To(Equal(`{"level":"INFO","msg":"msg","some":1}`))
+	})
+})
@@ -0,0 +1,158 @@
+package option_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.l0nax.org/typact"
+	"go.l0nax.org/typact/std/option"
+)
+
+type myInt int64
+
+type myString string
+
+var _ = Describe("SQL", func() {
+	var (
+		db  *sql.DB
+		drv *fakeDriver
+	)
+
+	BeforeEach(func() {
+		db, drv = openFakeDB()
+	})
+
+	AfterEach(func() {
+		Expect(db.Close()).To(Succeed())
+	})
+
+	// scan queries the fake database and scans the result into dest.
+	scan := func(src driver.Value, dest any) error {
+		drv.SetResult(src)
+
+		return db.QueryRow("SELECT value").Scan(dest)
+	}
+
+	Describe("Scan conversions", func() {
+		ts := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+
+		DescribeTable("should convert the driver value",
+			func(src driver.Value, dest any, expected any) {
+				Expect(scan(src, dest)).To(Succeed())
+				Expect(dest).To(Equal(expected))
+			},
+			Entry("int64 to int32", int64(42), &typact.Option[int32]{}, toPtr(typact.Some[int32](42))),
+			Entry("int64 to int8", int64(-5), &typact.Option[int8]{}, toPtr(typact.Some[int8](-5))),
+			Entry("int64 to uint16", int64(500), &typact.Option[uint16]{}, toPtr(typact.Some[uint16](500))),
+			Entry("int64 to int", int64(7), &typact.Option[int]{}, toPtr(typact.Some(7))),
+			Entry("int64 to float32", int64(3), &typact.Option[float32]{}, toPtr(typact.Some[float32](3))),
+			Entry("float64 to float32", float64(1.5), &typact.Option[float32]{}, toPtr(typact.Some[float32](1.5))),
+			Entry("int64 to string", int64(555), &typact.Option[string]{}, toPtr(typact.Some("555"))),
+			Entry("int64 to custom int", int64(9), &typact.Option[myInt]{}, toPtr(typact.Some[myInt](9))),
+			Entry("[]byte to string", []byte("foo"), &typact.Option[string]{}, toPtr(typact.Some("foo"))),
+			Entry("[]byte to custom string", []byte("foo"), &typact.Option[myString]{}, toPtr(typact.Some[myString]("foo"))),
+			Entry("[]byte to int64", []byte("123"), &typact.Option[int64]{}, toPtr(typact.Some[int64](123))),
+			Entry("[]byte to bool", []byte("true"), &typact.Option[bool]{}, toPtr(typact.Some(true))),
+			Entry("string to []byte", "bar", &typact.Option[[]byte]{}, toPtr(typact.Some([]byte("bar")))),
+			Entry("string to float64", "2.25", &typact.Option[float64]{}, toPtr(typact.Some(2.25))),
+			Entry("int64 to bool", int64(1), &typact.Option[bool]{}, toPtr(typact.Some(true))),
+			Entry("bool to string", true, &typact.Option[string]{}, toPtr(typact.Some("true"))),
+			Entry("time to time", ts, &typact.Option[time.Time]{}, toPtr(typact.Some(ts))),
+			Entry("time to string", ts, &typact.Option[string]{}, toPtr(typact.Some("2024-05-06T07:08:09Z"))),
+			Entry("RFC3339 string to time", "2024-05-06T07:08:09Z", &typact.Option[time.Time]{}, toPtr(typact.Some(ts))),
+			Entry("SQL string to time", []byte("2024-05-06 07:08:09"), &typact.Option[time.Time]{}, toPtr(typact.Some(ts))),
+			Entry("int64 to pointer", int64(3), &typact.Option[*int]{}, toPtr(typact.Some(toPtr(3)))),
+			Entry("NULL to int32", nil, &typact.Option[int32]{}, toPtr(typact.None[int32]())),
+			Entry("NULL to time", nil, &typact.Option[time.Time]{}, toPtr(typact.None[time.Time]())),
+		)
+
+		DescribeTable("should return an error",
+			func(src driver.Value, dest any) {
+				Expect(scan(src, dest)).ToNot(Succeed())
+			},
+			Entry("int64 overflow", int64(300), &typact.Option[int8]{}),
+			Entry("negative to unsigned", int64(-1), &typact.Option[uint]{}),
+			Entry("invalid number", "foo", &typact.Option[int]{}),
+			Entry("invalid bool", "maybe", &typact.Option[bool]{}),
+			Entry("invalid time", "yesterday", &typact.Option[time.Time]{}),
+			Entry("unsupported type", "foo", &typact.Option[struct{}]{}),
+		)
+
+		It("should reset the value on error", func() {
+			vv := typact.Some[int8](5)
+
+			Expect(scan(int64(300), &vv)).ToNot(Succeed())
+			Expect(vv.IsNone()).To(BeTrue())
+		})
+
+		It("should scan sql.Null values", func() {
+			var vv typact.Option[int32]
+
+			Expect(vv.Scan(sql.NullInt64{Int64: 12, Valid: true})).To(Succeed())
+			Expect(vv).To(Equal(typact.Some[int32](12)))
+
+			Expect(vv.Scan(sql.NullString{})).ToNot(Succeed())
+			Expect(vv.IsNone()).To(BeTrue())
+		})
+	})
+
+	Describe("Value", func() {
+		It("should pass the values to the driver", func() {
+			ts := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+
+			_, err := db.Exec("INSERT",
+				typact.Some[int32](5),
+				typact.Some("foo"),
+				typact.Some(ts),
+				typact.None[int64](),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(drv.LastArgs()).To(Equal([]driver.Value{int64(5), "foo", ts, nil}))
+		})
+	})
+
+	Describe("sql.Null conversions", func() {
+		It("should convert sql.Null[T]", func() {
+			Expect(option.FromNull(sql.Null[int]{V: 1, Valid: true})).To(Equal(typact.Some(1)))
+			Expect(option.FromNull(sql.Null[int]{V: 1})).To(Equal(typact.None[int]()))
+			Expect(option.ToNull(typact.Some(1))).To(Equal(sql.Null[int]{V: 1, Valid: true}))
+			Expect(option.ToNull(typact.None[int]())).To(Equal(sql.Null[int]{}))
+		})
+
+		It("should convert the typed sql.Null types", func() {
+			ts := time.Now()
+
+			Expect(option.FromNullString(sql.NullString{String: "foo", Valid: true})).To(Equal(typact.Some("foo")))
+			Expect(option.FromNullInt64(sql.NullInt64{})).To(Equal(typact.None[int64]()))
+			Expect(option.FromNullInt32(sql.NullInt32{Int32: 3, Valid: true})).To(Equal(typact.Some[int32](3)))
+			Expect(option.FromNullInt16(sql.NullInt16{Int16: 4, Valid: true})).To(Equal(typact.Some[int16](4)))
+			Expect(option.FromNullByte(sql.NullByte{Byte: 5, Valid: true})).To(Equal(typact.Some[byte](5)))
+			Expect(option.FromNullFloat64(sql.NullFloat64{Float64: 1.5, Valid: true})).To(Equal(typact.Some(1.5)))
+			Expect(option.FromNullBool(sql.NullBool{Bool: true, Valid: true})).To(Equal(typact.Some(true)))
+			Expect(option.FromNullTime(sql.NullTime{Time: ts, Valid: true})).To(Equal(typact.Some(ts)))
+
+			Expect(option.ToNullString(typact.Some("foo"))).To(Equal(sql.NullString{String: "foo", Valid: true}))
+			Expect(option.ToNullInt64(typact.None[int64]())).To(Equal(sql.NullInt64{}))
+			Expect(option.ToNullInt32(typact.Some[int32](3))).To(Equal(sql.NullInt32{Int32: 3, Valid: true}))
+			Expect(option.ToNullInt16(typact.Some[int16](4))).To(Equal(sql.NullInt16{Int16: 4, Valid: true}))
+			Expect(option.ToNullByte(typact.Some[byte](5))).To(Equal(sql.NullByte{Byte: 5, Valid: true}))
+			Expect(option.ToNullFloat64(typact.Some(1.5))).To(Equal(sql.NullFloat64{Float64: 1.5, Valid: true}))
+			Expect(option.ToNullBool(typact.Some(true))).To(Equal(sql.NullBool{Bool: true, Valid: true}))
+			Expect(option.ToNullTime(typact.Some(ts))).To(Equal(sql.NullTime{Time: ts, Valid: true}))
+		})
+
+		It("should round-trip through the database", func() {
+			var vv typact.Option[string]
+
+			Expect(scan("foo", &vv)).To(Succeed())
+
+			_, err := db.Exec("INSERT", option.ToNullString(vv))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(drv.LastArgs()).To(Equal([]driver.Value{"foo"}))
+		})
+	})
+})
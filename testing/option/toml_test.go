@@ -0,0 +1,174 @@
+package option_test
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.l0nax.org/typact"
+)
+
+var _ = Describe("TOML", func() {
+	type Server struct {
+		Host typact.Option[string] `toml:"host"`
+		Port typact.Option[uint16] `toml:"port"`
+	}
+
+	type Config struct {
+		Name      typact.Option[string]            `toml:"name"`
+		Raw       typact.Option[[]byte]            `toml:"raw"`
+		Int       typact.Option[int]               `toml:"int"`
+		Int8      typact.Option[int8]              `toml:"int8"`
+		Uint32    typact.Option[uint32]            `toml:"uint32"`
+		Float32   typact.Option[float32]           `toml:"float32"`
+		Float64   typact.Option[float64]           `toml:"float64"`
+		Bool      typact.Option[bool]              `toml:"bool"`
+		CreatedAt typact.Option[time.Time]         `toml:"created_at"`
+		Server    typact.Option[Server]            `toml:"server"`
+		Servers   typact.Option[[]Server]          `toml:"servers"`
+		Labels    typact.Option[map[string]string] `toml:"labels"`
+		Ports     typact.Option[[]int]             `toml:"ports"`
+	}
+
+	Context("Unmarshal", func() {
+		It("should decode all scalar types", func() {
+			const raw = `
+name = "foo"
+raw = "bar"
+int = -5
+int8 = 127
+uint32 = 4294967295
+float32 = 1.5
+float64 = 3
+bool = true
+created_at = 2024-12-23T10:00:00Z
+ports = [80, 443]
+`
+			var cfg Config
+
+			_, err := toml.Decode(raw, &cfg)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(cfg.Name.Unwrap()).To(Equal("foo"))
+			Expect(cfg.Raw.Unwrap()).To(Equal([]byte("bar")))
+			Expect(cfg.Int.Unwrap()).To(Equal(-5))
+			Expect(cfg.Int8.Unwrap()).To(BeEquivalentTo(127))
+			Expect(cfg.Uint32.Unwrap()).To(BeEquivalentTo(4294967295))
+			Expect(cfg.Float32.Unwrap()).To(BeEquivalentTo(1.5))
+			Expect(cfg.Float64.Unwrap()).To(BeEquivalentTo(3))
+			Expect(cfg.Bool.Unwrap()).To(BeTrue())
+			Expect(cfg.CreatedAt.Unwrap()).To(BeTemporally("==", time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC)))
+			Expect(cfg.Ports.Unwrap()).To(Equal([]int{80, 443}))
+			Expect(cfg.Server.IsNone()).To(BeTrue())
+		})
+
+		It("should decode nested tables", func() {
+			const raw = `
+[server]
+host = "localhost"
+
+[labels]
+env = "prod"
+
+[[servers]]
+host = "a"
+port = 80
+
+[[servers]]
+host = "b"
+`
+			var cfg Config
+
+			_, err := toml.Decode(raw, &cfg)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(cfg.Server.Unwrap()).To(Equal(Server{
+				Host: typact.Some("localhost"),
+			}))
+			Expect(cfg.Labels.Unwrap()).To(Equal(map[string]string{"env": "prod"}))
+			Expect(cfg.Servers.Unwrap()).To(Equal([]Server{
+				{Host: typact.Some("a"), Port: typact.Some[uint16](80)},
+				{Host: typact.Some("b")},
+			}))
+		})
+
+		It("should error on overflow", func() {
+			var cfg Config
+
+			_, err := toml.Decode(`int8 = 128`, &cfg)
+			Expect(err).To(MatchError(ContainSubstring("overflows")))
+			Expect(cfg.Int8.IsNone()).To(BeTrue())
+		})
+
+		It("should error on negative unsigned values", func() {
+			var cfg Config
+
+			_, err := toml.Decode(`uint32 = -1`, &cfg)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should error on type mismatch", func() {
+			var cfg Config
+
+			_, err := toml.Decode(`name = 5`, &cfg)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Marshal", func() {
+		DescribeTable("should produce valid TOML strings",
+			func(value string, expected string) {
+				data, err := typact.Some(value).MarshalTOML()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(data)).To(Equal(expected))
+
+				var out struct {
+					Value typact.Option[string] `toml:"value"`
+				}
+
+				_, err = toml.Decode("value = "+string(data), &out)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(out.Value.Unwrap()).To(Equal(value))
+			},
+			Entry("simple string", "foo bar", `'foo bar'`),
+			Entry("single quote", "it's", `"it's"`),
+			Entry("newline", "foo\nbar", `"foo\nbar"`),
+			Entry("double quote and backslash", "a'\"\\", `"a'\"\\"`),
+			Entry("control character", "a'\x01", `"a'\u0001"`),
+		)
+
+		It("should return an error for invalid UTF-8", func() {
+			_, err := typact.Some("foo\xffbar").MarshalTOML()
+			Expect(err).To(HaveOccurred())
+
+			_, err = typact.Some([]byte{'a', 0xc3}).MarshalTOML()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should round-trip with the encoder", func() {
+			src := Config{
+				Name:  typact.Some("it's\na test"),
+				Int:   typact.Some(-5),
+				Bool:  typact.Some(false),
+				Ports: typact.Some([]int{1, 2}),
+			}
+
+			var buf bytes.Buffer
+			Expect(toml.NewEncoder(&buf).Encode(struct {
+				Name typact.Option[string] `toml:"name"`
+				Int  typact.Option[int]    `toml:"int"`
+				Bool typact.Option[bool]   `toml:"bool"`
+			}{src.Name, src.Int, src.Bool})).To(Succeed())
+
+			var dst Config
+			_, err := toml.Decode(buf.String(), &dst)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dst.Name).To(Equal(src.Name))
+			Expect(dst.Int).To(Equal(src.Int))
+			Expect(dst.Bool).To(Equal(src.Bool))
+		})
+	})
+})
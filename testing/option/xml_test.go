@@ -0,0 +1,225 @@
+package option_test
+
+import (
+	"encoding/xml"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"go.l0nax.org/typact"
+)
+
+var _ = Describe("XML", func() {
+	type Address struct {
+		City typact.Option[string] `xml:"city"`
+		Zip  typact.Option[uint32] `xml:"zip,attr"`
+	}
+
+	type Tags struct {
+		Tag []string `xml:"tag"`
+	}
+
+	type Person struct {
+		XMLName   xml.Name                          `xml:"person"`
+		ID        typact.Option[int]                `xml:"id,attr"`
+		Active    typact.Option[bool]               `xml:"active,attr"`
+		Name      typact.Option[string]             `xml:"name"`
+		Age       typact.Option[uint8]              `xml:"age"`
+		Score     typact.Option[float64]            `xml:"score"`
+		CreatedAt typact.Option[time.Time]          `xml:"created_at"`
+		Address   typact.Option[Address]            `xml:"address"`
+		Tags      typact.Option[Tags]               `xml:"tags"`
+		Since     typact.Option[time.Time]          `xml:"since,attr"`
+		Nick      typact.Option[*string]            `xml:"nick"`
+		Nested    typact.Option[typact.Option[int]] `xml:"nested"`
+	}
+
+	Context("Marshal", func() {
+		It("should omit None elements and attributes", func() {
+			data, err := xml.Marshal(Person{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal("<person></person>"))
+		})
+
+		It("should encode Some values with their own rules", func() {
+			p := Person{
+				ID:        typact.Some(42),
+				Active:    typact.Some(false),
+				Name:      typact.Some("Gopher"),
+				Age:       typact.Some[uint8](13),
+				Score:     typact.Some(1.5),
+				CreatedAt: typact.Some(time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC)),
+				Address: typact.Some(Address{
+					City: typact.Some("Berlin"),
+					Zip:  typact.Some[uint32](10115),
+				}),
+				Tags:   typact.Some(Tags{Tag: []string{"a", "b"}}),
+				Since:  typact.Some(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)),
+				Nested: typact.Some(typact.Some(7)),
+			}
+
+			data, err := xml.Marshal(p)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`<person id="42" active="false" since="2020-01-02T00:00:00Z">` +
+				`<name>Gopher</name><age>13</age><score>1.5</score>` +
+				`<created_at>2024-12-23T10:00:00Z</created_at>` +
+				`<address zip="10115"><city>Berlin</city></address>` +
+				`<tags><tag>a</tag><tag>b</tag></tags>` +
+				`<nested>7</nested>` +
+				`</person>`))
+		})
+
+		It("should encode Some zero values", func() {
+			p := Person{
+				ID:   typact.Some(0),
+				Name: typact.Some(""),
+			}
+
+			data, err := xml.Marshal(p)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`<person id="0"><name></name></person>`))
+		})
+
+		It("should omit a None nested in Some", func() {
+			p := Person{
+				Nested: typact.Some(typact.None[int]()),
+			}
+
+			data, err := xml.Marshal(p)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal("<person></person>"))
+		})
+
+		It("should fail on unsupported attribute types", func() {
+			type Invalid struct {
+				Val typact.Option[[]int] `xml:"val,attr"`
+			}
+
+			_, err := xml.Marshal(Invalid{Val: typact.Some([]int{1})})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Unmarshal", func() {
+		It("should decode missing elements and attributes as None", func() {
+			var p Person
+
+			err := xml.Unmarshal([]byte("<person></person>"), &p)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(p.ID.IsNone()).To(BeTrue())
+			Expect(p.Active.IsNone()).To(BeTrue())
+			Expect(p.Name.IsNone()).To(BeTrue())
+			Expect(p.Age.IsNone()).To(BeTrue())
+			Expect(p.Address.IsNone()).To(BeTrue())
+			Expect(p.Tags.IsNone()).To(BeTrue())
+			Expect(p.Since.IsNone()).To(BeTrue())
+		})
+
+		It("should decode present values as Some", func() {
+			const raw = `<person id="42" active="true" since="2020-01-02T00:00:00Z">` +
+				`<name>Gopher</name><age>13</age><score>1.5</score>` +
+				`<created_at>2024-12-23T10:00:00Z</created_at>` +
+				`<address zip="10115"><city>Berlin</city></address>` +
+				`<tags><tag>a</tag><tag>b</tag></tags>` +
+				`<nick>go</nick>` +
+				`</person>`
+
+			var p Person
+
+			err := xml.Unmarshal([]byte(raw), &p)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(p.ID.Unwrap()).To(Equal(42))
+			Expect(p.Active.Unwrap()).To(BeTrue())
+			Expect(p.Name.Unwrap()).To(Equal("Gopher"))
+			Expect(p.Age.Unwrap()).To(BeEquivalentTo(13))
+			Expect(p.Score.Unwrap()).To(Equal(1.5))
+			Expect(p.CreatedAt.Unwrap()).To(BeTemporally("==", time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC)))
+			Expect(p.Since.Unwrap()).To(BeTemporally("==", time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)))
+			Expect(p.Tags.Unwrap().Tag).To(Equal([]string{"a", "b"}))
+			Expect(*p.Nick.Unwrap()).To(Equal("go"))
+
+			addr := p.Address.Unwrap()
+			Expect(addr.City.Unwrap()).To(Equal("Berlin"))
+			Expect(addr.Zip.Unwrap()).To(BeEquivalentTo(10115))
+		})
+
+		It("should decode an empty element as Some zero value", func() {
+			var p Person
+
+			err := xml.Unmarshal([]byte("<person><name></name></person>"), &p)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(p.Name.IsSome()).To(BeTrue())
+			Expect(p.Name.Unwrap()).To(BeEmpty())
+		})
+
+		DescribeTable("should decode xsi:nil as None",
+			func(raw string) {
+				p := Person{
+					Name:    typact.Some("previous"),
+					Address: typact.Some(Address{}),
+				}
+
+				err := xml.Unmarshal([]byte(raw), &p)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(p.Name.IsNone()).To(BeTrue())
+				Expect(p.Address.IsNone()).To(BeTrue())
+				Expect(p.Age.Unwrap()).To(BeEquivalentTo(1))
+			},
+			Entry("declared namespace",
+				`<person xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">`+
+					`<name xsi:nil="true"/><address xsi:nil="true"><city>x</city></address><age>1</age></person>`),
+			Entry("undeclared prefix",
+				`<person><name xsi:nil="true"></name><address xsi:nil="1"/><age>1</age></person>`),
+		)
+
+		It("should not treat xsi:nil=false as None", func() {
+			const raw = `<person xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">` +
+				`<name xsi:nil="false">Gopher</name></person>`
+
+			var p Person
+
+			err := xml.Unmarshal([]byte(raw), &p)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(p.Name.Unwrap()).To(Equal("Gopher"))
+		})
+
+		It("should reset the value on error", func() {
+			p := Person{
+				ID:  typact.Some(1),
+				Age: typact.Some[uint8](1),
+			}
+
+			err := xml.Unmarshal([]byte(`<person id="foo"></person>`), &p)
+			Expect(err).To(HaveOccurred())
+			Expect(p.ID.IsNone()).To(BeTrue())
+
+			err = xml.Unmarshal([]byte(`<person><age>300</age></person>`), &p)
+			Expect(err).To(HaveOccurred())
+			Expect(p.Age.IsNone()).To(BeTrue())
+		})
+
+		It("should round trip", func() {
+			in := Person{
+				ID:      typact.Some(-1),
+				Name:    typact.Some("Gopher & Co <3"),
+				Score:   typact.Some(0.1),
+				Address: typact.Some(Address{Zip: typact.Some[uint32](1)}),
+			}
+
+			data, err := xml.Marshal(in)
+			Expect(err).ToNot(HaveOccurred())
+
+			var out Person
+
+			err = xml.Unmarshal(data, &out)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out.ID).To(Equal(in.ID))
+			Expect(out.Name).To(Equal(in.Name))
+			Expect(out.Score).To(Equal(in.Score))
+			Expect(out.Address.Unwrap().Zip).To(Equal(in.Address.Unwrap().Zip))
+			Expect(out.Address.Unwrap().City.IsNone()).To(BeTrue())
+			Expect(out.Tags.IsNone()).To(BeTrue())
+		})
+	})
+})
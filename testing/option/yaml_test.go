@@ -0,0 +1,155 @@
+package option_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v3"
+
+	"go.l0nax.org/typact"
+)
+
+var _ = Describe("YAML", func() {
+	type Address struct {
+		Street typact.Option[string] `yaml:"street"`
+		Number typact.Option[int]    `yaml:"number"`
+	}
+
+	type Person struct {
+		Name    typact.Option[string]             `yaml:"name"`
+		Age     typact.Option[uint8]              `yaml:"age,omitempty"`
+		Address typact.Option[Address]            `yaml:"address"`
+		Tags    typact.Option[[]string]           `yaml:"tags"`
+		Scores  []typact.Option[float64]          `yaml:"scores,omitempty,flow"`
+		Nested  typact.Option[typact.Option[int]] `yaml:"nested"`
+	}
+
+	Context("Marshal", func() {
+		It("should encode None as null", func() {
+			data, err := yaml.Marshal(Person{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`name: null
+address: null
+tags: null
+nested: null
+`))
+		})
+
+		It("should encode Some as the native node", func() {
+			data, err := yaml.Marshal(Person{
+				Name: typact.Some("John"),
+				Age:  typact.Some[uint8](42),
+				Address: typact.Some(Address{
+					Street: typact.Some("Main Street"),
+				}),
+				Tags: typact.Some([]string{"foo", "bar"}),
+				Scores: []typact.Option[float64]{
+					typact.Some(1.5),
+					typact.None[float64](),
+				},
+				Nested: typact.Some(typact.Some(5)),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`name: John
+age: 42
+address:
+    street: Main Street
+    number: null
+tags:
+    - foo
+    - bar
+scores: [1.5, null]
+nested: 5
+`))
+		})
+	})
+
+	Context("Unmarshal", func() {
+		It("should decode null and missing fields as None", func() {
+			var data Person
+
+			err := yaml.Unmarshal([]byte("name: null\naddress: ~\n"), &data)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(data.Name.IsNone()).To(BeTrue())
+			Expect(data.Age.IsNone()).To(BeTrue())
+			Expect(data.Address.IsNone()).To(BeTrue())
+			Expect(data.Tags.IsNone()).To(BeTrue())
+		})
+
+		It("should decode nested structs and sequences", func() {
+			const raw = `
+name: John
+age: 42
+address:
+  street: Main Street
+tags: [foo, bar]
+scores: [1.5, 3]
+nested: 5
+`
+			var data Person
+
+			err := yaml.Unmarshal([]byte(raw), &data)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(data.Name.Unwrap()).To(Equal("John"))
+			Expect(data.Age.Unwrap()).To(BeEquivalentTo(42))
+			Expect(data.Address.Unwrap().Street.Unwrap()).To(Equal("Main Street"))
+			Expect(data.Address.Unwrap().Number.IsNone()).To(BeTrue())
+			Expect(data.Tags.Unwrap()).To(Equal([]string{"foo", "bar"}))
+			Expect(data.Scores).To(Equal([]typact.Option[float64]{
+				typact.Some(1.5),
+				typact.Some(3.0),
+			}))
+			Expect(data.Nested.Unwrap().Unwrap()).To(Equal(5))
+		})
+
+		It("should return type errors", func() {
+			var data Person
+
+			err := yaml.Unmarshal([]byte("age: foo\n"), &data)
+			Expect(err).To(HaveOccurred())
+			Expect(data.Age.IsNone()).To(BeTrue())
+		})
+
+		// gopkg.in/yaml.v3 does not call any unmarshaler for 'null' nodes.
+		It("should skip null entries of a sequence", func() {
+			var data Person
+
+			err := yaml.Unmarshal([]byte("scores: [1.5, null, 3]\n"), &data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data.Scores).To(Equal([]typact.Option[float64]{
+				typact.Some(1.5),
+				typact.Some(3.0),
+			}))
+		})
+	})
+
+	DescribeTable("should round-trip values",
+		func(src Person) {
+			data, err := yaml.Marshal(src)
+			Expect(err).ToNot(HaveOccurred())
+
+			var dst Person
+			Expect(yaml.Unmarshal(data, &dst)).To(Succeed())
+			Expect(dst).To(Equal(src))
+		},
+		Entry("None", Person{}),
+		Entry("scalars", Person{
+			Name: typact.Some("John"),
+			Age:  typact.Some[uint8](42),
+		}),
+		Entry("nested struct", Person{
+			Address: typact.Some(Address{
+				Street: typact.Some("Main Street"),
+				Number: typact.Some(7),
+			}),
+		}),
+		Entry("sequences", Person{
+			Tags:   typact.Some([]string{"foo", "bar"}),
+			Scores: []typact.Option[float64]{typact.Some(2.5), typact.Some(-1.0)},
+		}),
+		Entry("nested Option", Person{
+			Nested: typact.Some(typact.Some(5)),
+		}),
+	)
+})
@@ -0,0 +1,347 @@
+package typact
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.l0nax.org/typact/internal/types"
+)
+
+// timeType holds the [reflect.Type] of [time.Time].
+var timeType = reflect.TypeOf(time.Time{})
+
+// UnmarshalTOML implements the TOML unmarshaler interface as used by,
+// e.g., github.com/BurntSushi/toml.
+//
+// The data is expected to be in the decoded form, i.e. one of:
+// string, int64, float64, bool, [time.Time], []any or map[string]any.
+//
+// If T implements the TOML unmarshaler interface itself, the custom method
+// will be called. Tables are decoded into structs (honouring the "toml" tag)
+// and maps with string keys.
+//
+// NOTE: TOML does not have a null value, thus a missing key results in [None].
+func (o *Option[T]) UnmarshalTOML(data any) error {
+	// reset first
+	o.some = false
+
+	if data == nil {
+		// only allocate in slow path.
+		// this overrides any previously defined value in the field.
+		o.val = types.ZeroValue[T]()
+
+		return nil
+	}
+
+	var val T
+
+	if err := unmarshalTOMLValue(reflect.ValueOf(&val).Elem(), data); err != nil {
+		// only allocate in slow path.
+		// this overrides any previously defined value in the field.
+		o.val = types.ZeroValue[T]()
+
+		return fmt.Errorf("unable to unmarshal TOML: %w", err)
+	}
+
+	o.val = val
+	o.some = true
+
+	return nil
+}
+
+// unmarshalTOMLValue decodes src into dst.
+// dst must be settable.
+func unmarshalTOMLValue(dst reflect.Value, src any) error {
+	if dst.CanAddr() {
+		switch dec := dst.Addr().Interface().(type) {
+		case tomlUnmarshaler:
+			return dec.UnmarshalTOML(src)
+
+		case encoding.TextUnmarshaler:
+			if str, ok := src.(string); ok {
+				return dec.UnmarshalText(string2Bytes(str))
+			}
+		}
+	}
+
+	if tt, ok := src.(time.Time); ok && dst.Type() == timeType {
+		dst.Set(reflect.ValueOf(tt))
+
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.Pointer:
+		ptr := reflect.New(dst.Type().Elem())
+		if err := unmarshalTOMLValue(ptr.Elem(), src); err != nil {
+			return err
+		}
+
+		dst.Set(ptr)
+
+		return nil
+
+	case reflect.Interface:
+		if dst.NumMethod() != 0 {
+			break
+		}
+
+		dst.Set(reflect.ValueOf(src))
+
+		return nil
+
+	case reflect.String:
+		if str, ok := src.(string); ok {
+			dst.SetString(str)
+
+			return nil
+		}
+
+	case reflect.Bool:
+		if b, ok := src.(bool); ok {
+			dst.SetBool(b)
+
+			return nil
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		num, ok := src.(int64)
+		if !ok {
+			break
+		}
+
+		if dst.OverflowInt(num) {
+			return fmt.Errorf("value %d overflows %v", num, dst.Type())
+		}
+
+		dst.SetInt(num)
+
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		num, ok := src.(int64)
+		if !ok {
+			break
+		}
+
+		if num < 0 || dst.OverflowUint(uint64(num)) {
+			return fmt.Errorf("value %d overflows %v", num, dst.Type())
+		}
+
+		dst.SetUint(uint64(num))
+
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		var num float64
+
+		switch val := src.(type) {
+		case float64:
+			num = val
+		case int64:
+			num = float64(val)
+		default:
+			return fmt.Errorf("cannot unmarshal %T into %v", src, dst.Type())
+		}
+
+		if dst.OverflowFloat(num) {
+			return fmt.Errorf("value %v overflows %v", num, dst.Type())
+		}
+
+		dst.SetFloat(num)
+
+		return nil
+
+	case reflect.Slice:
+		if str, ok := src.(string); ok && dst.Type().Elem().Kind() == reflect.Uint8 {
+			dst.SetBytes([]byte(str))
+
+			return nil
+		}
+
+		srcVal := reflect.ValueOf(src)
+		if srcVal.Kind() != reflect.Slice {
+			break
+		}
+
+		ret := reflect.MakeSlice(dst.Type(), srcVal.Len(), srcVal.Len())
+		for i := range srcVal.Len() {
+			if err := unmarshalTOMLValue(ret.Index(i), srcVal.Index(i).Interface()); err != nil {
+				return fmt.Errorf("index %d: %w", i, err)
+			}
+		}
+
+		dst.Set(ret)
+
+		return nil
+
+	case reflect.Array:
+		srcVal := reflect.ValueOf(src)
+		if srcVal.Kind() != reflect.Slice {
+			break
+		}
+
+		if srcVal.Len() != dst.Len() {
+			return fmt.Errorf("cannot unmarshal array of length %d into %v", srcVal.Len(), dst.Type())
+		}
+
+		for i := range srcVal.Len() {
+			if err := unmarshalTOMLValue(dst.Index(i), srcVal.Index(i).Interface()); err != nil {
+				return fmt.Errorf("index %d: %w", i, err)
+			}
+		}
+
+		return nil
+
+	case reflect.Map:
+		table, ok := src.(map[string]any)
+		if !ok || dst.Type().Key().Kind() != reflect.String {
+			break
+		}
+
+		ret := reflect.MakeMapWithSize(dst.Type(), len(table))
+		for key, raw := range table {
+			val := reflect.New(dst.Type().Elem()).Elem()
+			if err := unmarshalTOMLValue(val, raw); err != nil {
+				return fmt.Errorf("key %q: %w", key, err)
+			}
+
+			ret.SetMapIndex(reflect.ValueOf(key).Convert(dst.Type().Key()), val)
+		}
+
+		dst.Set(ret)
+
+		return nil
+
+	case reflect.Struct:
+		table, ok := src.(map[string]any)
+		if !ok {
+			break
+		}
+
+		return unmarshalTOMLTable(dst, table)
+	}
+
+	return fmt.Errorf("cannot unmarshal %T into %v", src, dst.Type())
+}
+
+// unmarshalTOMLTable decodes table into the struct dst.
+//
+// Fields are matched by their "toml" tag, or by their name (case-insensitive).
+// Unknown keys are ignored.
+func unmarshalTOMLTable(dst reflect.Value, table map[string]any) error {
+	typ := dst.Type()
+
+	for i := range typ.NumField() {
+		fld := typ.Field(i)
+		if !fld.IsExported() {
+			continue
+		}
+
+		name := fld.Name
+		if tag, ok := fld.Tag.Lookup("toml"); ok {
+			tag, _, _ = strings.Cut(tag, ",")
+
+			switch tag {
+			case "-":
+				continue
+			case "":
+			default:
+				name = tag
+			}
+		}
+
+		raw, ok := table[name]
+		if !ok {
+			raw, ok = lookupFold(table, name)
+			if !ok {
+				continue
+			}
+		}
+
+		if err := unmarshalTOMLValue(dst.Field(i), raw); err != nil {
+			return fmt.Errorf("field %s: %w", fld.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// lookupFold looks up key in table using case-insensitive matching.
+func lookupFold(table map[string]any, key string) (any, bool) {
+	for k, v := range table {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+// quoteTOMLString returns s as valid TOML string.
+//
+// A literal string is returned whenever possible, otherwise
+// a basic string with all required characters escaped.
+//
+// An error is returned if s is not valid UTF-8, since TOML
+// cannot represent such strings.
+func quoteTOMLString(s string) (string, error) {
+	if !utf8.ValidString(s) {
+		return "", fmt.Errorf("cannot marshal invalid UTF-8 string %q", s)
+	}
+
+	if isTOMLLiteralSafe(s) {
+		return `'` + s + `'`, nil
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s) + 2)
+	sb.WriteByte('"')
+
+	for _, r := range s {
+		switch r {
+		case '"':
+			sb.WriteString(`\"`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\b':
+			sb.WriteString(`\b`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\f':
+			sb.WriteString(`\f`)
+		case '\r':
+			sb.WriteString(`\r`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&sb, `\u%04X`, r)
+
+				continue
+			}
+
+			sb.WriteRune(r)
+		}
+	}
+
+	sb.WriteByte('"')
+
+	return sb.String(), nil
+}
+
+// isTOMLLiteralSafe reports whether s can be represented as
+// single-line TOML literal string.
+func isTOMLLiteralSafe(s string) bool {
+	for _, r := range s {
+		if r == '\'' || r == 0x7f || (r < 0x20 && r != '\t') {
+			return false
+		}
+	}
+
+	return true
+}
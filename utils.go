@@ -1,35 +1,18 @@
 package typact
 
 import (
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
 	"reflect"
 	"unsafe"
 
-	"go.l0nax.org/typact/internal/features"
+	"go.l0nax.org/typact/internal/types"
 )
 
-// isScalarCopyable returns whether the given Kind is a scalar type
-// which can be copied by value.
-func isScalarCopyable(kind reflect.Kind) bool {
-	switch kind {
-	case reflect.Bool,
-		reflect.Uintptr,
-		reflect.Func,
-		reflect.UnsafePointer,
-		reflect.Invalid,
-		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
-		reflect.Float32, reflect.Float64,
-		reflect.Complex64, reflect.Complex128:
-
-		return true
-	case reflect.String:
-		// WARN: With arenas, a string cannot be simply copied.
-		return !features.GoArenaAvail
-	}
-
-	return false
-}
-
 // string2Bytes converts the given string to a byte slice without memory allocation.
 //
 // Note it may break if string and/or slice header will change in future go versions.
@@ -45,3 +28,62 @@ func bytes2String(bs []byte) string {
 type tomlMarshaler interface {
 	MarshalTOML() ([]byte, error)
 }
+
+type tomlUnmarshaler interface {
+	UnmarshalTOML(data any) error
+}
+
+// stateCloner is implemented by types which must be cloned using the state
+// of the active deep clone, see [optionCloneHook].
+type stateCloner interface {
+	cloneUsing(c types.DeepCloner) (any, error)
+}
+
+// stateClonerType is the [reflect.Type] of [stateCloner].
+var stateClonerType = reflect.TypeFor[stateCloner]()
+
+type yamlMarshaler interface {
+	MarshalYAML() (any, error)
+}
+
+type yamlUnmarshaler interface {
+	UnmarshalYAML(unmarshal func(any) error) error
+}
+
+// binaryAppender mirrors encoding.BinaryAppender, which is only available since Go 1.24.
+type binaryAppender interface {
+	AppendBinary(b []byte) ([]byte, error)
+}
+
+// textAppender mirrors encoding.TextAppender, which is only available since Go 1.24.
+type textAppender interface {
+	AppendText(b []byte) ([]byte, error)
+}
+
+var (
+	_ yamlMarshaler   = Option[int]{}
+	_ yamlUnmarshaler = (*Option[int])(nil)
+
+	_ xml.Marshaler       = Option[int]{}
+	_ xml.Unmarshaler     = (*Option[int])(nil)
+	_ xml.MarshalerAttr   = Option[int]{}
+	_ xml.UnmarshalerAttr = (*Option[int])(nil)
+
+	_ encoding.BinaryMarshaler   = Option[int]{}
+	_ encoding.BinaryUnmarshaler = (*Option[int])(nil)
+	_ gob.GobEncoder             = Option[int]{}
+	_ gob.GobDecoder             = (*Option[int])(nil)
+
+	_ json.Marshaler   = Nullable[int]{}
+	_ json.Unmarshaler = (*Nullable[int])(nil)
+
+	_ json.Marshaler   = OmitNone[int]{}
+	_ json.Unmarshaler = (*OmitNone[int])(nil)
+
+	_ fmt.Formatter  = Option[int]{}
+	_ fmt.GoStringer = Option[int]{}
+
+	_ slog.LogValuer = Option[int]{}
+
+	_ stateCloner = Option[int]{}
+)
@@ -0,0 +1,252 @@
+package typact
+
+import (
+	"encoding"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"go.l0nax.org/typact/internal/types"
+)
+
+// xsiNamespace is the XML Schema instance namespace which defines the "nil" attribute.
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// MarshalXML implements the [xml.Marshaler] interface.
+//
+// If it is [None], the element is omitted entirely.
+// Otherwise the underlying value is encoded using its own XML rules,
+// i.e. the struct tags, [xml.Marshaler] or [encoding.TextMarshaler] of T are honoured.
+func (o Option[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !o.some {
+		return nil
+	}
+
+	return e.EncodeElement(o.val, start)
+}
+
+// UnmarshalXML implements the [xml.Unmarshaler] interface.
+//
+// An element with the attribute xsi:nil="true" is decoded as [None],
+// any other element is decoded into T using its own XML rules.
+//
+// NOTE: encoding/xml does not call this method for missing elements,
+// thus the zero value, i.e. [None], is retained.
+//
+// WARN: encoding/xml calls this method once per element, thus repeated
+// elements, e.g. with a slice T or an "a>b" tag, only retain the last element.
+// Wrap the slice in a struct instead.
+func (o *Option[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	// reset first
+	o.some = false
+
+	if isXMLNil(start) {
+		// only allocate in slow path.
+		// this overrides any previously defined value in the field.
+		o.val = types.ZeroValue[T]()
+
+		return d.Skip()
+	}
+
+	var val T
+
+	if err := d.DecodeElement(&val, &start); err != nil {
+		o.val = types.ZeroValue[T]()
+
+		return err
+	}
+
+	o.val = val
+	o.some = true
+
+	return nil
+}
+
+// MarshalXMLAttr implements the [xml.MarshalerAttr] interface.
+//
+// If it is [None], the attribute is omitted entirely.
+// Otherwise the underlying value is encoded using its [xml.MarshalerAttr]
+// or [encoding.TextMarshaler] implementation. Scalar types, strings and
+// byte slices are formatted the same way as encoding/xml does.
+func (o Option[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !o.some {
+		// an empty attribute name makes encoding/xml omit the attribute.
+		return xml.Attr{}, nil
+	}
+
+	switch val := any(o.val).(type) {
+	case xml.MarshalerAttr:
+		return val.MarshalXMLAttr(name)
+
+	case encoding.TextMarshaler:
+		text, err := val.MarshalText()
+		if err != nil {
+			return xml.Attr{}, err
+		}
+
+		return xml.Attr{Name: name, Value: string(text)}, nil
+	}
+
+	text, err := formatXMLAttr(reflect.ValueOf(&o.val).Elem())
+	if err != nil {
+		return xml.Attr{}, err
+	}
+
+	return xml.Attr{Name: name, Value: text}, nil
+}
+
+// UnmarshalXMLAttr implements the [xml.UnmarshalerAttr] interface.
+//
+// The attribute value is decoded using the [xml.UnmarshalerAttr] or
+// [encoding.TextUnmarshaler] implementation of T. Scalar types, strings
+// and byte slices are parsed the same way as encoding/xml does.
+//
+// NOTE: encoding/xml does not call this method for missing attributes,
+// thus the zero value, i.e. [None], is retained.
+func (o *Option[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	// reset first
+	o.some = false
+
+	var (
+		val T
+		err error
+	)
+
+	switch dec := any(&val).(type) {
+	case xml.UnmarshalerAttr:
+		err = dec.UnmarshalXMLAttr(attr)
+
+	case encoding.TextUnmarshaler:
+		err = dec.UnmarshalText([]byte(attr.Value))
+
+	default:
+		err = parseXMLAttr(reflect.ValueOf(&val).Elem(), attr.Value)
+	}
+
+	if err != nil {
+		// only allocate in slow path.
+		// this overrides any previously defined value in the field.
+		o.val = types.ZeroValue[T]()
+
+		return err
+	}
+
+	o.val = val
+	o.some = true
+
+	return nil
+}
+
+// isXMLNil reports whether start has the attribute xsi:nil="true".
+func isXMLNil(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if attr.Name.Local != "nil" {
+			continue
+		}
+
+		// NOTE: The prefix is only resolved to the namespace
+		// if it has been declared in the document.
+		if attr.Name.Space != xsiNamespace && attr.Name.Space != "xsi" {
+			continue
+		}
+
+		switch strings.TrimSpace(attr.Value) {
+		case "true", "1":
+			return true
+		}
+	}
+
+	return false
+}
+
+// formatXMLAttr returns the attribute representation of rv.
+func formatXMLAttr(rv reflect.Value) (string, error) {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), nil
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return string(rv.Bytes()), nil
+		}
+	}
+
+	return "", fmt.Errorf("xml: unsupported attribute type %s", rv.Type())
+}
+
+// parseXMLAttr parses s into rv.
+func parseXMLAttr(rv reflect.Value, s string) error {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if s == "" {
+			return nil
+		}
+
+		num, err := strconv.ParseInt(strings.TrimSpace(s), 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		rv.SetInt(num)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if s == "" {
+			return nil
+		}
+
+		num, err := strconv.ParseUint(strings.TrimSpace(s), 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		rv.SetUint(num)
+
+	case reflect.Float32, reflect.Float64:
+		if s == "" {
+			return nil
+		}
+
+		num, err := strconv.ParseFloat(strings.TrimSpace(s), rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		rv.SetFloat(num)
+
+	case reflect.Bool:
+		if s == "" {
+			return nil
+		}
+
+		val, err := strconv.ParseBool(strings.TrimSpace(s))
+		if err != nil {
+			return err
+		}
+
+		rv.SetBool(val)
+
+	case reflect.String:
+		rv.SetString(s)
+
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("xml: unsupported attribute type %s", rv.Type())
+		}
+
+		rv.SetBytes([]byte(s))
+
+	default:
+		return fmt.Errorf("xml: unsupported attribute type %s", rv.Type())
+	}
+
+	return nil
+}